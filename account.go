@@ -0,0 +1,43 @@
+package runware
+
+import (
+	"context"
+	"fmt"
+
+	models "github.com/Ryank90/runware-go-sdk/models"
+)
+
+// AccountDetails returns the account balance, usage per period and API key metadata
+func (c *Client) AccountDetails(ctx context.Context) (*models.AccountManagementResponse, error) {
+	result, err := c.sendRequest(ctx, models.NewAccountDetailsRequest())
+	if err != nil {
+		return nil, err
+	}
+	if resp, ok := result.(*models.AccountManagementResponse); ok {
+		return resp, nil
+	}
+	return nil, ErrInvalidResponse
+}
+
+// EnsureBalance checks that the account balance is at least minimum, for example
+// before launching a large batch. The account details are returned in either case.
+//
+// Returns an error wrapping ErrInsufficientBalance if the balance is too low.
+//
+// Example:
+//
+//	if _, err := client.EnsureBalance(ctx, 25); errors.Is(err, runware.ErrInsufficientBalance) {
+//	    alertLowCredit(err)
+//	    return err
+//	}
+//	responses, err := client.ImageInferenceBatch(ctx, requests)
+func (c *Client) EnsureBalance(ctx context.Context, minimum float64) (*models.AccountManagementResponse, error) {
+	details, err := c.AccountDetails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if details.Balance < minimum {
+		return details, fmt.Errorf("%w: balance %.2f is below required %.2f", ErrInsufficientBalance, details.Balance, minimum)
+	}
+	return details, nil
+}
@@ -0,0 +1,62 @@
+package runware
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Ryank90/runware-go-sdk/models"
+)
+
+func newAccountTestClient(t *testing.T, balance float64) *Client {
+	return newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		if task["taskType"] != models.TaskTypeAccountManagement || task["operation"] != "getDetails" {
+			t.Errorf("task = %v, want accountManagement getDetails", task)
+		}
+		return map[string]interface{}{"data": []map[string]interface{}{{
+			"taskType":         task["taskType"],
+			"taskUUID":         task["taskUUID"],
+			"organizationName": "Acme",
+			"balance":          balance,
+			"usage": []map[string]interface{}{
+				{"period": "last24h", "credits": 1.5, "requests": 120},
+				{"period": "last30d", "credits": 42.0, "requests": 3100},
+			},
+			"apiKeys": []map[string]interface{}{{"name": "production", "enabled": true}},
+		}}}
+	})
+}
+
+func TestAccountDetails(t *testing.T) {
+	client := newAccountTestClient(t, 12.5)
+
+	details, err := client.AccountDetails(context.Background())
+	if err != nil {
+		t.Fatalf("AccountDetails() error = %v", err)
+	}
+	if details.Balance != 12.5 || details.OrganizationName != "Acme" {
+		t.Errorf("details = %+v, want Acme with balance 12.5", details)
+	}
+	if usage, ok := details.UsageFor("last30d"); !ok || usage.Requests != 3100 {
+		t.Errorf("UsageFor(last30d) = %+v, %v", usage, ok)
+	}
+	if len(details.APIKeys) != 1 || !details.APIKeys[0].Enabled {
+		t.Errorf("APIKeys = %+v, want one enabled key", details.APIKeys)
+	}
+}
+
+func TestEnsureBalance(t *testing.T) {
+	client := newAccountTestClient(t, 5)
+
+	if _, err := client.EnsureBalance(context.Background(), 1); err != nil {
+		t.Errorf("EnsureBalance(1) error = %v", err)
+	}
+
+	details, err := client.EnsureBalance(context.Background(), 10)
+	if !errors.Is(err, ErrInsufficientBalance) {
+		t.Errorf("EnsureBalance(10) error = %v, want ErrInsufficientBalance", err)
+	}
+	if details == nil || details.Balance != 5 {
+		t.Error("account details should be returned with the error")
+	}
+}
@@ -24,13 +24,14 @@ package runware
 
 import (
 	"context"
-	"encoding/base64"
+	"errors"
 	"fmt"
 	"log"
 	"os"
 	"sync"
 	"time"
 
+	restinternal "github.com/Ryank90/runware-go-sdk/internal/rest"
 	wsinternal "github.com/Ryank90/runware-go-sdk/internal/ws"
 	models "github.com/Ryank90/runware-go-sdk/models"
 )
@@ -59,13 +60,15 @@ func (s *stdLogger) Printf(format string, v ...interface{}) {
 //
 // Client instances are safe for concurrent use by multiple goroutines.
 // A single Client can handle multiple simultaneous requests efficiently
-// through multiplexed WebSocket communication.
+// through multiplexed WebSocket communication, or over HTTP when
+// Config.Transport is TransportHTTP.
 type Client struct {
-	ws             *wsinternal.Client
+	transport      transport
 	apiKey         string
 	config         *Config
 	requestTimeout time.Duration
 	debugLogger    DebugLogger
+	limiter        *rateLimiter
 }
 
 // Config contains client configuration options.
@@ -77,10 +80,18 @@ type Config struct {
 	// If empty when calling NewClient, it will be read from the RUNWARE_API_KEY environment variable.
 	APIKey string
 
+	// Transport selects how requests are sent to the API.
+	// Default: TransportWebSocket. Use TransportHTTP for serverless functions and short-lived jobs.
+	Transport TransportType
+
 	// WSConfig contains WebSocket-specific configuration (connection timeouts, reconnection settings, etc.).
 	// If nil, DefaultWSConfig() will be used.
 	WSConfig *wsinternal.WSConfig
 
+	// HTTPConfig contains HTTP transport configuration (endpoint URL, HTTP client, timeout).
+	// Only used when Transport is TransportHTTP. If nil, DefaultHTTPConfig() will be used.
+	HTTPConfig *restinternal.HTTPConfig
+
 	// RequestTimeout is the default timeout for API requests.
 	// Individual requests may override this timeout using context.WithTimeout.
 	// Default: 120 seconds (suitable for video/image generation).
@@ -93,6 +104,32 @@ type Config struct {
 	// DebugLogger is a custom logger for debug output.
 	// If nil and EnableDebugLogging is true, logs will be written to standard log output.
 	DebugLogger DebugLogger
+
+	// RetryPolicy configures automatic retries of failed requests.
+	// If nil, requests are not retried. See DefaultRetryPolicy().
+	RetryPolicy *RetryPolicy
+
+	// RateLimit throttles outgoing requests on the client side, globally and per task type.
+	// Requests over the limit wait in a queue until admitted or their context is canceled.
+	// If nil, requests are sent immediately.
+	RateLimit *RateLimit
+
+	// MaxUploadSize limits the size in bytes of files and readers uploaded by the
+	// Upload*FromFile and Upload*FromReader helpers. Their content is streamed, so
+	// the limit does not bound memory use. URL uploads are not limited.
+	// Default: 0, which applies DefaultMaxUploadSize (1 GiB).
+	MaxUploadSize int64
+
+	// DisableValidation skips the local Validate() check that requests must pass
+	// before they are sent. Invalid requests are then only rejected by the API.
+	DisableValidation bool
+
+	// OnError is called for API errors that are not associated with a task,
+	// such as authentication failures or malformed requests without a taskUUID,
+	// on both the WebSocket and HTTP transports.
+	// Errors returned by the API are delivered as *APIError. It is called on its
+	// own goroutine and must not block.
+	OnError func(err error)
 }
 
 // DefaultConfig returns a client configuration with sensible defaults.
@@ -108,7 +145,9 @@ func DefaultConfig() *Config {
 
 	return &Config{
 		APIKey:             "",
+		Transport:          TransportWebSocket,
 		WSConfig:           wsinternal.DefaultWSConfig(),
+		HTTPConfig:         restinternal.DefaultHTTPConfig(),
 		RequestTimeout:     120 * time.Second, // Generous timeout for image/video generation
 		EnableDebugLogging: debugEnabled,
 	}
@@ -163,7 +202,8 @@ func NewClient(config *Config) (*Client, error) {
 		config:         config,
 		requestTimeout: config.RequestTimeout,
 		debugLogger:    debugLogger,
-		ws:             wsinternal.NewClient(config.APIKey, config.WSConfig, debugLogger),
+		transport:      newTransport(config, debugLogger),
+		limiter:        newRateLimiter(config.RateLimit),
 	}
 
 	return client, nil
@@ -175,6 +215,14 @@ func NewClient(config *Config) (*Client, error) {
 // uses WebSockets for efficient, bidirectional communication and supports
 // automatic reconnection if configured.
 //
+// When Config.Transport is TransportHTTP, Connect only marks the client ready;
+// each request is sent as its own HTTP POST.
+//
+// Connect waits for the API to accept the authentication request and returns an
+// error wrapping ErrInvalidAPIKey if it is rejected. The connectionSessionUUID
+// returned by the API is presented on automatic reconnects so that results of
+// tasks submitted before a connection drop are still delivered.
+//
 // Returns an error if the connection cannot be established within the configured timeout.
 //
 // Example:
@@ -184,7 +232,7 @@ func NewClient(config *Config) (*Client, error) {
 //	    log.Fatal("Failed to connect:", err)
 //	}
 //	defer client.Disconnect()
-func (c *Client) Connect(ctx context.Context) error { return c.ws.Connect(ctx) }
+func (c *Client) Connect(ctx context.Context) error { return c.transport.Connect(ctx) }
 
 // Disconnect closes the WebSocket connection to the Runware API.
 //
@@ -192,13 +240,13 @@ func (c *Client) Connect(ctx context.Context) error { return c.ws.Connect(ctx) }
 // up resources. It's safe to call Disconnect multiple times.
 //
 // Any in-flight requests will receive an error after disconnection.
-func (c *Client) Disconnect() error { return c.ws.Disconnect() }
+func (c *Client) Disconnect() error { return c.transport.Disconnect() }
 
 // IsConnected returns whether the client currently has an active connection
 // to the Runware API.
 //
 // This method is safe to call from multiple goroutines.
-func (c *Client) IsConnected() bool { return c.ws.IsConnected() }
+func (c *Client) IsConnected() bool { return c.transport.IsConnected() }
 
 // ImageInference performs AI-powered image generation with full control over generation parameters.
 //
@@ -214,6 +262,9 @@ func (c *Client) IsConnected() bool { return c.ws.IsConnected() }
 //   - Model: AI model to use (e.g., "runware:101@1")
 //   - Width and Height: Output dimensions in pixels
 //
+// When NumberResults is greater than 1 only the first image is returned;
+// use ImageInferenceAll to receive every generated image.
+//
 // Returns ErrNotConnected if the client is not connected.
 // Returns ErrInvalidRequest if the request is nil or invalid.
 //
@@ -240,6 +291,34 @@ func (c *Client) ImageInference(ctx context.Context, req *models.ImageInferenceR
 	return result.(*models.ImageInferenceResponse), nil
 }
 
+// ImageInferenceAll performs image generation and returns every result for the task.
+//
+// Unlike ImageInference, which returns only the first image, this method waits for
+// all NumberResults images. If the request times out or fails after some images have
+// been delivered, the partial results are returned alongside the error (typically a
+// *TimeoutError reporting how many results were received).
+//
+// Example:
+//
+//	req := runware.NewRequestBuilder("mountain landscape", "runware:101@1", 1024, 1024).
+//	    WithNumberResults(4).
+//	    Build()
+//	images, err := client.ImageInferenceAll(ctx, req)
+//	if err != nil && len(images) == 0 {
+//	    log.Fatal(err)
+//	}
+func (c *Client) ImageInferenceAll(ctx context.Context, req *models.ImageInferenceRequest) ([]*models.ImageInferenceResponse, error) {
+	if req == nil {
+		return nil, ErrInvalidRequest
+	}
+
+	if req.TaskType == "" {
+		req.TaskType = models.TaskTypeImageInference
+	}
+
+	return collectResults[*models.ImageInferenceResponse](c.sendRequestAll(ctx, req))
+}
+
 // ImageInferenceBatch performs multiple image inference requests in parallel with bounded concurrency.
 //
 // This method efficiently processes multiple image generation requests concurrently,
@@ -280,18 +359,19 @@ func (c *Client) UploadImage(ctx context.Context, req *models.UploadImageRequest
 	return result.(*models.UploadImageResponse), nil
 }
 
-// UploadImageFromFile uploads an image from a file path
+// UploadImageFromFile uploads an image from a file path.
+// Files larger than Config.MaxUploadSize fail with ErrPayloadTooLarge without being read.
 func (c *Client) UploadImageFromFile(ctx context.Context, filePath string) (*models.UploadImageResponse, error) {
-	data, err := os.ReadFile(filePath) // #nosec G304 - file path is provided by user for upload
+	f, err := os.Open(filePath) // #nosec G304 - file path is provided by user for upload
 	if err != nil {
 		return nil, fmt.Errorf("failed to read file: %w", err)
 	}
+	defer f.Close()
 
-	base64Data := base64.StdEncoding.EncodeToString(data)
-	req := models.NewUploadImageRequest()
-	req.ImageBase64 = &base64Data
-
-	return c.UploadImage(ctx, req)
+	if info, err := f.Stat(); err == nil && info.Size() > c.maxUploadSize() {
+		return nil, fmt.Errorf("%w: %s is %d bytes (limit %d)", ErrPayloadTooLarge, filePath, info.Size(), c.maxUploadSize())
+	}
+	return c.UploadImageFromReader(ctx, f, nil)
 }
 
 // UploadImageFromURL uploads an image from a URL
@@ -370,9 +450,99 @@ func (c *Client) CaptionImage(ctx context.Context, req *models.ImageCaptionReque
 	return result.(*models.ImageCaptionResponse), nil
 }
 
+// ControlNetPreprocess produces a ControlNet guide image (edges, depth, pose, ...) from an input image.
+// The returned GuideImageUUID can be passed to RequestBuilder.WithControlNet.
+func (c *Client) ControlNetPreprocess(ctx context.Context, req *models.ControlNetPreprocessRequest) (*models.ControlNetPreprocessResponse, error) {
+	if req == nil {
+		return nil, ErrInvalidRequest
+	}
+
+	if req.TaskType == "" {
+		req.TaskType = models.TaskTypeControlNetPreprocess
+	}
+
+	result, err := c.sendRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp, ok := result.(*models.ControlNetPreprocessResponse); ok {
+		return resp, nil
+	}
+	return nil, ErrInvalidResponse
+}
+
+// PhotoMaker generates images of the person shown in 1-4 input photos.
+// The prompt must contain models.PhotoMakerTriggerWord ("rwre") where the subject should appear.
+// When NumberResults is greater than 1, only the first image is returned; use PhotoMakerAll to get every image.
+func (c *Client) PhotoMaker(ctx context.Context, req *models.PhotoMakerRequest) (*models.PhotoMakerResponse, error) {
+	results, err := c.PhotoMakerAll(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, ErrInvalidResponse
+	}
+	return results[0], nil
+}
+
+// PhotoMakerAll generates PhotoMaker images and returns every result.
+// Partial results are returned alongside the error if the request times out or fails.
+func (c *Client) PhotoMakerAll(ctx context.Context, req *models.PhotoMakerRequest) ([]*models.PhotoMakerResponse, error) {
+	if req == nil {
+		return nil, ErrInvalidRequest
+	}
+
+	if req.TaskType == "" {
+		req.TaskType = models.TaskTypePhotoMaker
+	}
+
+	return collectResults[*models.PhotoMakerResponse](c.sendRequestAll(ctx, req))
+}
+
+// ImageMasking detects faces, hands or people in an image and returns a mask covering them,
+// along with the bounding box of each detection. The MaskImageUUID can be passed to Inpaint.
+func (c *Client) ImageMasking(ctx context.Context, req *models.ImageMaskingRequest) (*models.ImageMaskingResponse, error) {
+	if req == nil {
+		return nil, ErrInvalidRequest
+	}
+
+	if req.TaskType == "" {
+		req.TaskType = models.TaskTypeImageMasking
+	}
+
+	result, err := c.sendRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp, ok := result.(*models.ImageMaskingResponse); ok {
+		return resp, nil
+	}
+	return nil, ErrInvalidResponse
+}
+
+// Vectorize converts a raster image into an SVG vector graphic
+func (c *Client) Vectorize(ctx context.Context, req *models.VectorizeRequest) (*models.VectorizeResponse, error) {
+	if req == nil {
+		return nil, ErrInvalidRequest
+	}
+
+	if req.TaskType == "" {
+		req.TaskType = models.TaskTypeVectorize
+	}
+
+	result, err := c.sendRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp, ok := result.(*models.VectorizeResponse); ok {
+		return resp, nil
+	}
+	return nil, ErrInvalidResponse
+}
+
 // VideoInference performs video inference (async only - returns acknowledgment)
 // For video generation, this returns quickly with just the taskUUID acknowledgment.
-// Use PollVideoResult() or GetResponse() to retrieve the actual video result.
+// Use VideoInferenceAsync() to get a Job that waits for the actual video result.
 func (c *Client) VideoInference(ctx context.Context, req *models.VideoInferenceRequest) (*models.VideoInferenceResponse, error) {
 	if req == nil {
 		return nil, ErrInvalidRequest
@@ -390,45 +560,193 @@ func (c *Client) VideoInference(ctx context.Context, req *models.VideoInferenceR
 	return result.(*models.VideoInferenceResponse), nil
 }
 
+// VideoInferenceAll submits a video inference request and returns every response for the task.
+// Partial results are returned alongside the error if the request times out or fails.
+func (c *Client) VideoInferenceAll(ctx context.Context, req *models.VideoInferenceRequest) ([]*models.VideoInferenceResponse, error) {
+	if req == nil {
+		return nil, ErrInvalidRequest
+	}
+
+	if req.TaskType == "" {
+		req.TaskType = models.TaskTypeVideoInference
+	}
+
+	return collectResults[*models.VideoInferenceResponse](c.sendRequestAll(ctx, req))
+}
+
 // VideoInferenceBatch performs multiple video inference requests in parallel
 func (c *Client) VideoInferenceBatch(ctx context.Context, requests []*models.VideoInferenceRequest) ([]*models.VideoInferenceResponse, error) {
 	return processBatch(ctx, requests, c.VideoInference)
 }
 
-// sendRequest is a generic method to send a request and wait for response
+// VideoUpscale upscales a video (async only - returns acknowledgment).
+// Use VideoUpscaleAsync() to get a Job that waits for the upscaled video.
+func (c *Client) VideoUpscale(ctx context.Context, req *models.VideoUpscaleRequest) (*models.VideoUpscaleResponse, error) {
+	if req == nil {
+		return nil, ErrInvalidRequest
+	}
+
+	if req.TaskType == "" {
+		req.TaskType = models.TaskTypeVideoUpscale
+	}
+
+	result, err := c.sendRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp, ok := result.(*models.VideoUpscaleResponse); ok {
+		return resp, nil
+	}
+	return nil, ErrInvalidResponse
+}
+
+// VideoBackgroundRemoval removes the background from a video (async only - returns acknowledgment).
+// Use VideoBackgroundRemovalAsync() to get a Job that waits for the processed video.
+func (c *Client) VideoBackgroundRemoval(ctx context.Context, req *models.VideoBackgroundRemovalRequest) (*models.VideoBackgroundRemovalResponse, error) {
+	if req == nil {
+		return nil, ErrInvalidRequest
+	}
+
+	if req.TaskType == "" {
+		req.TaskType = models.TaskTypeVideoBackgroundRemoval
+	}
+
+	result, err := c.sendRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp, ok := result.(*models.VideoBackgroundRemovalResponse); ok {
+		return resp, nil
+	}
+	return nil, ErrInvalidResponse
+}
+
+// sendRequest is a generic method to send a request and wait for response.
+// For multi-result tasks only the first response is returned; use
+// sendRequestAll to receive every result.
 func (c *Client) sendRequest(ctx context.Context, req interface{}) (interface{}, error) {
-	if !c.IsConnected() {
-		return nil, ErrNotConnected
+	results, err := c.sendRequestAll(ctx, req)
+	if err != nil {
+		return nil, err
 	}
+	if len(results) == 0 {
+		return nil, ErrInvalidResponse
+	}
+	return results[0], nil
+}
+
+// pendingTask tracks a submitted request whose responses are still being collected
+type pendingTask struct {
+	taskType      string
+	taskUUID      string
+	expectedCount int
+	respChan      chan interface{}
+	errChan       chan error
+	release       func()
+}
+
+// sendRequestAll sends a request and waits for every expected response, retrying
+// according to Config.RetryPolicy. On timeout or error, any results received so far
+// are returned alongside the error.
+func (c *Client) sendRequestAll(ctx context.Context, req interface{}) ([]interface{}, error) {
+	return c.sendWithRetry(ctx, req)
+}
+
+// validate checks a request locally unless validation is disabled. Failures wrap both
+// ErrInvalidRequest and the *models.ValidationError listing the invalid fields.
+func (c *Client) validate(req interface{}) error {
+	if c.config != nil && c.config.DisableValidation {
+		return nil
+	}
+	if v, ok := req.(models.Validator); ok {
+		if err := v.Validate(); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+		}
+	}
+	return nil
+}
+
+// sendOnce performs a single attempt of a request
+func (c *Client) sendOnce(ctx context.Context, req interface{}) ([]interface{}, error) {
+	task, err := c.submitRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer task.release()
+
+	results, err := c.waitForResponse(ctx, task.taskType, task.taskUUID, task.expectedCount, task.respChan, task.errChan)
+	if err != nil && task.taskUUID != "" {
+		// Stop routing late responses for an abandoned task to its handler
+		c.transport.RemoveHandler(task.taskUUID)
+	}
+	return results, err
+}
 
+// submitRequest registers a response handler and sends the request without waiting.
+// The caller must call task.release once it has finished collecting responses.
+func (c *Client) submitRequest(ctx context.Context, req interface{}) (*pendingTask, error) {
 	expectedCount := c.extractExpectedCount(req)
-	respChan := make(chan interface{}, expectedCount)
-	errChan := make(chan error, 1)
+	task := &pendingTask{
+		expectedCount: expectedCount,
+		respChan:      make(chan interface{}, expectedCount),
+		errChan:       make(chan error, 1),
+		release:       func() {},
+	}
 
 	// Define cleanup to remove handler from websocket after final response
-	var taskUUID, taskType string
-	if ti, ok := req.(models.TaskIdentifiable); ok {
-		taskUUID = ti.GetTaskUUID()
-		taskType = ti.GetTaskType()
-	}
 	onDone := func() {
-		if taskUUID != "" {
-			c.ws.RemoveHandler(taskUUID)
+		if task.taskUUID != "" {
+			c.transport.RemoveHandler(task.taskUUID)
 		}
 	}
-	handler := c.createResponseHandler(expectedCount, respChan, errChan, onDone)
+	handler := c.createResponseHandler(expectedCount, task.respChan, task.errChan, onDone)
 
-	// Extract task info for error reporting (already set above)
+	if err := c.submitWithHandler(ctx, req, task, handler); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// submitWithHandler validates and sends a request whose responses are delivered to
+// handler. It fills in the task's identifiers and its rate limit release, which the
+// caller must call once the request is finished.
+func (c *Client) submitWithHandler(
+	ctx context.Context,
+	req interface{},
+	task *pendingTask,
+	handler func(interface{}, error),
+) error {
+	if err := c.validate(req); err != nil {
+		return err
+	}
+	if !c.IsConnected() {
+		return ErrNotConnected
+	}
+
+	if ti, ok := req.(models.TaskIdentifiable); ok {
+		task.taskUUID = ti.GetTaskUUID()
+		task.taskType = ti.GetTaskType()
+	}
+
+	// Wait for admission under the configured rate limit
+	if c.limiter != nil {
+		release, err := c.limiter.acquire(ctx, task.taskType)
+		if err != nil {
+			return err
+		}
+		task.release = release
+	}
 
 	c.debugLogger.Printf("Submitting request: %s (TaskUUID: %s, expecting %d results)",
-		taskType, taskUUID, expectedCount)
+		task.taskType, task.taskUUID, task.expectedCount)
 
 	// Send the request
-	if err := c.ws.Send(ctx, req, handler); err != nil {
-		return nil, err
+	if err := c.transport.Send(ctx, req, handler); err != nil {
+		task.release()
+		return err
 	}
 
-	return c.waitForResponse(ctx, taskType, taskUUID, expectedCount, respChan, errChan)
+	return nil
 }
 
 // extractExpectedCount extracts the numberResults from a request
@@ -459,9 +777,14 @@ func (c *Client) createResponseHandler(
 		mu.Lock()
 		defer mu.Unlock()
 
+		// Ignore anything delivered after the final response
+		if receivedCount >= expectedCount {
+			return
+		}
+
 		if err != nil {
 			select {
-			case errChan <- err:
+			case errChan <- wrapTransportError(err):
 			default:
 			}
 			return
@@ -486,7 +809,7 @@ func (c *Client) waitForResponse(
 	expectedCount int,
 	respChan chan interface{},
 	errChan chan error,
-) (interface{}, error) {
+) ([]interface{}, error) {
 	timeout := c.requestTimeout
 	if deadline, ok := ctx.Deadline(); ok {
 		timeout = time.Until(deadline)
@@ -501,17 +824,20 @@ func (c *Client) waitForResponse(
 	// For single result, return immediately
 	if expectedCount == 1 {
 		result, err := c.waitForSingleResponse(ctx, respChan, errChan, timeoutTimer)
-		if err != nil && IsTimeout(err) {
-			// Return enhanced timeout error
-			return nil, &TimeoutError{
-				TaskType:      taskType,
-				TaskUUID:      taskUUID,
-				Duration:      time.Since(startTime),
-				ExpectedCount: expectedCount,
-				ReceivedCount: 0,
+		if err != nil {
+			if IsTimeout(err) {
+				// Return enhanced timeout error
+				return nil, &TimeoutError{
+					TaskType:      taskType,
+					TaskUUID:      taskUUID,
+					Duration:      time.Since(startTime),
+					ExpectedCount: expectedCount,
+					ReceivedCount: 0,
+				}
 			}
+			return nil, err
 		}
-		return result, err
+		return []interface{}{result}, nil
 	}
 
 	// For multiple results, wait for all
@@ -539,7 +865,8 @@ func (c *Client) waitForSingleResponse(
 	}
 }
 
-// waitForMultipleResponses waits for multiple responses
+// waitForMultipleResponses waits for multiple responses, returning every result
+// received. Partial results are returned alongside the error on failure.
 func (c *Client) waitForMultipleResponses(
 	ctx context.Context,
 	taskType, taskUUID string,
@@ -548,39 +875,49 @@ func (c *Client) waitForMultipleResponses(
 	respChan chan interface{},
 	errChan chan error,
 	timeoutTimer <-chan time.Time,
-) (interface{}, error) {
-	var firstResp interface{}
-	receivedCount := 0
+) ([]interface{}, error) {
+	results := make([]interface{}, 0, expectedCount)
 
 	for {
 		select {
 		case <-ctx.Done():
-			return nil, ctx.Err()
+			return results, ctx.Err()
 		case err := <-errChan:
-			return nil, err
+			return results, err
 		case resp, ok := <-respChan:
-			if firstResp == nil {
-				firstResp = resp
-			}
-			receivedCount++
-			c.debugLogger.Printf("Received %d/%d results for %s (TaskUUID: %s)",
-				receivedCount, expectedCount, taskType, taskUUID)
 			if !ok {
-				return firstResp, nil
+				return results, nil
 			}
+			results = append(results, resp)
+			c.debugLogger.Printf("Received %d/%d results for %s (TaskUUID: %s)",
+				len(results), expectedCount, taskType, taskUUID)
 		case <-timeoutTimer:
 			// Return enhanced timeout error with partial results info
-			return nil, &TimeoutError{
+			return results, &TimeoutError{
 				TaskType:      taskType,
 				TaskUUID:      taskUUID,
 				Duration:      time.Since(startTime),
 				ExpectedCount: expectedCount,
-				ReceivedCount: receivedCount,
+				ReceivedCount: len(results),
 			}
 		}
 	}
 }
 
+// collectResults converts raw transport results into typed responses,
+// preserving any error (such as a TimeoutError) alongside the partial results.
+func collectResults[T any](results []interface{}, err error) ([]T, error) {
+	typed := make([]T, 0, len(results))
+	for _, r := range results {
+		resp, ok := r.(T)
+		if !ok {
+			return typed, errors.Join(fmt.Errorf("%w: got %T", ErrInvalidResponse, r), err)
+		}
+		typed = append(typed, resp)
+	}
+	return typed, err
+}
+
 // Helper methods for common operations
 
 // TextToImage generates an image from a text prompt
@@ -604,88 +941,37 @@ func (c *Client) ImageToVideo(ctx context.Context, prompt, model, seedImage stri
 	return c.VideoInference(ctx, req)
 }
 
-// GetResponse polls for the result of an async task
-// Returns either *VideoInferenceResponse or *AudioInferenceResponse depending on the task
-func (c *Client) GetResponse(ctx context.Context, taskUUID string) (interface{}, error) {
+// GetResponse retrieves the results of an async task.
+//
+// Every result delivered for the task is returned, typed according to the
+// taskType of the original request (image inference, upscale, background
+// removal, video or audio). Results of task types this SDK does not recognize
+// are kept in GetResponseResult.Unknown.
+func (c *Client) GetResponse(ctx context.Context, taskUUID string) (*models.GetResponseResult, error) {
 	req := models.NewGetResponseRequest(taskUUID)
 
 	result, err := c.sendRequest(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	return result, nil
+	if resp, ok := result.(*models.GetResponseResult); ok {
+		return resp, nil
+	}
+	return nil, ErrInvalidResponse
 }
 
-// PollVideoResult polls for video generation result with automatic retries
-// This function will keep polling until it gets a definitive response (success/error)
-// or reaches maxAttempts. Each individual poll has its own timeout, but the overall
-// polling process can run as long as needed.
+// PollVideoResult polls for the result of a video generation task every pollInterval,
+// at most maxAttempts times.
+//
+// Deprecated: Use VideoInferenceAsync or VideoJob and Job.Wait, which support
+// configurable backoff and report server failures as *JobError.
 func (c *Client) PollVideoResult(
 	ctx context.Context,
 	taskUUID string,
 	maxAttempts int,
 	pollInterval time.Duration,
 ) (*models.VideoInferenceResponse, error) {
-	c.debugLogger.Printf("Starting video polling for TaskUUID: %s (max attempts: %d, interval: %v)",
-		taskUUID, maxAttempts, pollInterval)
-
-	for attempt := 1; attempt <= maxAttempts; attempt++ {
-		// Check if parent context was canceled
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
-		}
-
-		// Create a fresh context for each poll with a reasonable timeout
-		// This allows the overall polling to continue beyond the client's default timeout
-		pollCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-		resp, err := c.GetResponse(pollCtx, taskUUID)
-		cancel()
-
-		if err != nil {
-			c.debugLogger.Printf("Poll attempt %d/%d: error - %v", attempt, maxAttempts, err)
-			// If it's a timeout on this specific poll, continue trying
-			if IsTimeout(err) || err == context.DeadlineExceeded {
-				c.debugLogger.Printf("Poll request timed out, will retry...")
-				time.Sleep(pollInterval)
-				continue
-			}
-			// For other errors, return immediately
-			return nil, err
-		}
-
-		// Type assert to VideoInferenceResponse
-		videoResp, ok := resp.(*models.VideoInferenceResponse)
-		if !ok {
-			return nil, fmt.Errorf("unexpected response type from getResponse")
-		}
-
-		c.debugLogger.Printf("Poll attempt %d/%d: status = %s", attempt, maxAttempts, videoResp.Status)
-
-		switch videoResp.Status {
-		case models.TaskStatusSuccess:
-			c.debugLogger.Printf("Video generation completed successfully!")
-			return videoResp, nil
-		case models.TaskStatusError:
-			return nil, fmt.Errorf("video generation failed - check API response for details")
-		case models.TaskStatusProcessing, "":
-			// Continue polling - status is still processing or not set yet
-			c.debugLogger.Printf("Video still processing, waiting %v before next poll...", pollInterval)
-			select {
-			case <-ctx.Done():
-				return nil, ctx.Err()
-			case <-time.After(pollInterval):
-				continue
-			}
-		default:
-			// Unknown status, log it but continue polling
-			c.debugLogger.Printf("Unknown status: %s, continuing to poll...", videoResp.Status)
-			time.Sleep(pollInterval)
-		}
-	}
-
-	return nil, fmt.Errorf("polling exhausted: reached max attempts (%d) without definitive response", maxAttempts)
+	return c.VideoJob(taskUUID).WithBackoff(fixedIntervalBackoff(maxAttempts, pollInterval)).Wait(ctx)
 }
 
 // ImageToImage transforms an image based on a prompt
@@ -716,6 +1002,33 @@ func (c *Client) Inpaint(
 	return c.ImageInference(ctx, req)
 }
 
+// AutoInpaintFaces detects the faces in seedImage and inpaints them in one call.
+// It generates a face mask with ImageMasking and passes it to Inpaint.
+//
+// Returns an error wrapping ErrNoDetections if no face is found.
+func (c *Client) AutoInpaintFaces(
+	ctx context.Context,
+	prompt, model, seedImage string,
+	width, height int,
+	strength float64,
+) (*models.ImageInferenceResponse, error) {
+	mask, err := c.ImageMasking(ctx, models.NewImageMaskingRequest(seedImage, models.MaskingModelFaceYOLOv8n))
+	if err != nil {
+		return nil, fmt.Errorf("face masking failed: %w", err)
+	}
+	if len(mask.Detections) == 0 || mask.MaskImageUUID == "" {
+		return nil, fmt.Errorf("%w: no faces found in seed image", ErrNoDetections)
+	}
+
+	c.debugLogger.Printf("Inpainting %d detected faces (mask: %s)", len(mask.Detections), mask.MaskImageUUID)
+
+	// The masking task registers the seed image, so reuse its UUID when available
+	if mask.InputImageUUID != "" {
+		seedImage = mask.InputImageUUID
+	}
+	return c.Inpaint(ctx, prompt, model, seedImage, mask.MaskImageUUID, width, height, strength)
+}
+
 // Outpaint performs outpainting on an image
 func (c *Client) Outpaint(
 	ctx context.Context,
@@ -856,6 +1169,36 @@ func (rb *RequestBuilder) WithRefiner(model string, startStep int) *RequestBuild
 	return rb
 }
 
+// WithPuLID enables PuLID identity preservation using the given reference face images
+func (rb *RequestBuilder) WithPuLID(inputImages []string, idWeight float64) *RequestBuilder {
+	rb.req.PuLID = &models.PuLID{
+		InputImages: inputImages,
+		IDWeight:    &idWeight,
+	}
+	return rb
+}
+
+// WithACEPlusPlusFaceSwap enables ACE++ face swapping with the given reference image
+func (rb *RequestBuilder) WithACEPlusPlusFaceSwap(referenceImage string) *RequestBuilder {
+	rb.req.ACEPlusPlus = &models.ACEPlusPlus{
+		Type:        models.ACEPlusPlusTypeFaceSwap,
+		InputImages: []string{referenceImage},
+	}
+	return rb
+}
+
+// WithACEPlusPlusRepainting enables ACE++ repainting of the masked area of the reference image.
+// repaintingScale (0-1) balances following the prompt against preserving the reference.
+func (rb *RequestBuilder) WithACEPlusPlusRepainting(referenceImage, mask string, repaintingScale float64) *RequestBuilder {
+	rb.req.ACEPlusPlus = &models.ACEPlusPlus{
+		Type:            models.ACEPlusPlusTypeRepainting,
+		InputImages:     []string{referenceImage},
+		InputMasks:      []string{mask},
+		RepaintingScale: &repaintingScale,
+	}
+	return rb
+}
+
 // WithSafety enables safety checks
 func (rb *RequestBuilder) WithSafety(mode models.SafetyMode) *RequestBuilder {
 	checkContent := true
@@ -880,7 +1223,8 @@ func (rb *RequestBuilder) Build() *models.ImageInferenceRequest {
 // VideoRequestBuilder provides a fluent interface for building video requests
 type VideoRequestBuilder struct{ req *models.VideoInferenceRequest }
 
-// NewVideoRequestBuilder creates a new video request builder
+// NewVideoRequestBuilder creates a new video request builder. The resolution, duration
+// and frame rate start at the defaults registered for the model.
 func NewVideoRequestBuilder(prompt, model string) *VideoRequestBuilder {
 	return &VideoRequestBuilder{req: models.NewVideoInferenceRequest(prompt, model)}
 }
@@ -891,7 +1235,8 @@ func (vb *VideoRequestBuilder) WithNegativePrompt(prompt string) *VideoRequestBu
 	return vb
 }
 
-// WithDuration sets the video duration in seconds (1-10)
+// WithDuration sets the video duration in seconds. Many models only support specific
+// durations; see models.VideoCapabilities.
 func (vb *VideoRequestBuilder) WithDuration(duration int) *VideoRequestBuilder {
 	vb.req.Duration = &duration
 	return vb
@@ -1049,11 +1394,23 @@ func (vb *VideoRequestBuilder) WithIncludeCost(include bool) *VideoRequestBuilde
 	return vb
 }
 
-// Build returns the built video request
+// Build returns the built video request. Invalid requests are rejected when they
+// are sent; use BuildChecked to check them up front.
 func (vb *VideoRequestBuilder) Build() *models.VideoInferenceRequest {
 	return vb.req
 }
 
+// BuildChecked returns the built video request, or an error wrapping ErrInvalidRequest
+// and *models.ValidationError if the request is invalid or combines settings the model does
+// not support (see models.LookupVideoCapabilities), such as an unsupported resolution,
+// duration or frame position, input audio, or another provider's settings.
+func (vb *VideoRequestBuilder) BuildChecked() (*models.VideoInferenceRequest, error) {
+	if err := vb.req.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+	return vb.req, nil
+}
+
 // AudioInference generates audio using the full request object
 func (c *Client) AudioInference(
 	ctx context.Context,
@@ -1071,6 +1428,19 @@ func (c *Client) AudioInference(
 	return nil, ErrInvalidResponse
 }
 
+// AudioInferenceAll generates audio and returns every response for the task.
+// Partial results are returned alongside the error if the request times out or fails.
+func (c *Client) AudioInferenceAll(
+	ctx context.Context,
+	request *models.AudioInferenceRequest,
+) ([]*models.AudioInferenceResponse, error) {
+	if request == nil {
+		return nil, ErrInvalidRequest
+	}
+
+	return collectResults[*models.AudioInferenceResponse](c.sendRequestAll(ctx, request))
+}
+
 // TextToAudio is a convenience method for simple text-to-audio generation
 func (c *Client) TextToAudio(
 	ctx context.Context,
@@ -1082,74 +1452,18 @@ func (c *Client) TextToAudio(
 	return c.AudioInference(ctx, req)
 }
 
-// PollAudioResult polls for audio generation result with automatic retries
-// This function will keep polling until it gets a definitive response (success/error)
-// or reaches maxAttempts. Each individual poll has its own timeout, but the overall
-// polling process can run as long as needed.
+// PollAudioResult polls for the result of an audio generation task every pollInterval,
+// at most maxAttempts times.
+//
+// Deprecated: Use AudioInferenceAsync or AudioJob and Job.Wait, which support
+// configurable backoff and report server failures as *JobError.
 func (c *Client) PollAudioResult(
 	ctx context.Context,
 	taskUUID string,
 	maxAttempts int,
 	pollInterval time.Duration,
 ) (*models.AudioInferenceResponse, error) {
-	c.debugLogger.Printf("Starting audio polling for TaskUUID: %s (max attempts: %d, interval: %v)",
-		taskUUID, maxAttempts, pollInterval)
-
-	for attempt := 1; attempt <= maxAttempts; attempt++ {
-		// Check if parent context was canceled
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
-		}
-
-		// Create a fresh context for each poll with a reasonable timeout
-		// This allows the overall polling to continue beyond the client's default timeout
-		pollCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-		resp, err := c.GetResponse(pollCtx, taskUUID)
-		cancel()
-
-		if err != nil {
-			c.debugLogger.Printf("Poll attempt %d/%d: error - %v", attempt, maxAttempts, err)
-			// If it's a timeout on this specific poll, continue trying
-			if IsTimeout(err) || err == context.DeadlineExceeded {
-				c.debugLogger.Printf("Poll request timed out, will retry...")
-				time.Sleep(pollInterval)
-				continue
-			}
-			// For other errors, return immediately
-			return nil, err
-		}
-
-		// The response type depends on what we're polling for
-		// Try to cast to AudioInferenceResponse
-		if audioResp, ok := resp.(*models.AudioInferenceResponse); ok {
-			c.debugLogger.Printf("Poll attempt %d/%d: status = %s", attempt, maxAttempts, audioResp.Status)
-
-			switch audioResp.Status {
-			case models.TaskStatusSuccess:
-				c.debugLogger.Printf("Audio generation completed successfully!")
-				return audioResp, nil
-			case models.TaskStatusError:
-				return nil, fmt.Errorf("audio generation failed - check API response for details")
-			case models.TaskStatusProcessing, "":
-				// Continue polling - status is still processing or not set yet
-				c.debugLogger.Printf("Audio still processing, waiting %v before next poll...", pollInterval)
-				select {
-				case <-ctx.Done():
-					return nil, ctx.Err()
-				case <-time.After(pollInterval):
-					continue
-				}
-			default:
-				// Unknown status, log it but continue polling
-				c.debugLogger.Printf("Unknown status: %s, continuing to poll...", audioResp.Status)
-				time.Sleep(pollInterval)
-			}
-		}
-	}
-
-	return nil, fmt.Errorf("polling exhausted: reached max attempts (%d) without definitive response", maxAttempts)
+	return c.AudioJob(taskUUID).WithBackoff(fixedIntervalBackoff(maxAttempts, pollInterval)).Wait(ctx)
 }
 
 // AudioRequestBuilder provides a fluent interface for building audio inference requests
@@ -2,6 +2,7 @@ package runware
 
 import (
 	"context"
+	"errors"
 	"os"
 	"testing"
 	"time"
@@ -446,3 +447,310 @@ func TestDefaultConfigTimeout(t *testing.T) {
 		t.Errorf("RequestTimeout %v seems too short for production use", config.RequestTimeout)
 	}
 }
+
+func TestWaitForResponseReturnsAllResults(t *testing.T) {
+	client := &Client{
+		requestTimeout: 5 * time.Second,
+		debugLogger:    &defaultLogger{},
+	}
+
+	expected := 4
+	respChan := make(chan interface{}, expected)
+	errChan := make(chan error, 1)
+	handler := client.createResponseHandler(expected, respChan, errChan, nil)
+
+	go func() {
+		for i := 0; i < expected; i++ {
+			handler(&models.ImageInferenceResponse{ImageUUID: string(rune('a' + i))}, nil)
+		}
+		// Extra deliveries after completion must be ignored
+		handler(&models.ImageInferenceResponse{ImageUUID: "extra"}, nil)
+	}()
+
+	results, err := collectResults[*models.ImageInferenceResponse](
+		client.waitForResponse(context.Background(), models.TaskTypeImageInference, testUUID, expected, respChan, errChan),
+	)
+	if err != nil {
+		t.Fatalf("waitForResponse() error = %v", err)
+	}
+	if len(results) != expected {
+		t.Fatalf("got %d results, want %d", len(results), expected)
+	}
+	for i, r := range results {
+		if want := string(rune('a' + i)); r.ImageUUID != want {
+			t.Errorf("results[%d].ImageUUID = %q, want %q", i, r.ImageUUID, want)
+		}
+	}
+}
+
+func TestWaitForResponsePartialTimeout(t *testing.T) {
+	client := &Client{
+		requestTimeout: 100 * time.Millisecond,
+		debugLogger:    &defaultLogger{},
+	}
+
+	expected := 3
+	respChan := make(chan interface{}, expected)
+	errChan := make(chan error, 1)
+	handler := client.createResponseHandler(expected, respChan, errChan, nil)
+	handler(&models.ImageInferenceResponse{ImageUUID: "first"}, nil)
+
+	results, err := collectResults[*models.ImageInferenceResponse](
+		client.waitForResponse(context.Background(), models.TaskTypeImageInference, testUUID, expected, respChan, errChan),
+	)
+
+	var timeoutErr *TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("error = %v, want *TimeoutError", err)
+	}
+	if timeoutErr.ReceivedCount != 1 || timeoutErr.ExpectedCount != expected {
+		t.Errorf("TimeoutError counts = %d/%d, want 1/%d", timeoutErr.ReceivedCount, timeoutErr.ExpectedCount, expected)
+	}
+	if len(results) != 1 || results[0].ImageUUID != "first" {
+		t.Errorf("partial results = %v, want one result with ImageUUID \"first\"", results)
+	}
+}
+
+func TestCollectResultsTypeMismatchKeepsError(t *testing.T) {
+	timeoutErr := &TimeoutError{TaskType: models.TaskTypeImageInference, ExpectedCount: 2, ReceivedCount: 1}
+	_, err := collectResults[*models.ImageInferenceResponse](
+		[]interface{}{&models.VideoInferenceResponse{}}, timeoutErr,
+	)
+	if !errors.Is(err, ErrInvalidResponse) {
+		t.Errorf("error = %v, want ErrInvalidResponse", err)
+	}
+	var gotTimeout *TimeoutError
+	if !errors.As(err, &gotTimeout) || gotTimeout.ReceivedCount != 1 {
+		t.Errorf("error = %v, want the TimeoutError preserved", err)
+	}
+}
+
+func TestImageInferenceAllWithoutConnection(t *testing.T) {
+	config := DefaultConfig()
+	config.APIKey = testAPIKey
+	client, err := NewClient(config)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if _, err := client.ImageInferenceAll(context.Background(), nil); err != ErrInvalidRequest {
+		t.Errorf("ImageInferenceAll(nil) error = %v, want %v", err, ErrInvalidRequest)
+	}
+
+	req := models.NewImageInferenceRequest("test", "model", 512, 512)
+	if _, err := client.ImageInferenceAll(context.Background(), req); err != ErrNotConnected {
+		t.Errorf("ImageInferenceAll() error = %v, want %v", err, ErrNotConnected)
+	}
+}
+
+func TestControlNetPreprocess(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		if task["taskType"] != models.TaskTypeControlNetPreprocess || task["preProcessorType"] != "canny" {
+			t.Errorf("task = %v, want canny preprocessing", task)
+		}
+		if task["lowThresholdCanny"] != float64(100) || task["highThresholdCanny"] != float64(200) {
+			t.Errorf("thresholds = %v/%v, want 100/200", task["lowThresholdCanny"], task["highThresholdCanny"])
+		}
+		return map[string]interface{}{"data": []map[string]interface{}{{
+			"taskType":       task["taskType"],
+			"taskUUID":       task["taskUUID"],
+			"guideImageUUID": "guide-1",
+			"guideImageURL":  "https://example.com/guide.png",
+		}}}
+	})
+
+	req := models.NewControlNetPreprocessRequest("input-uuid", models.ControlNetPreprocessorCanny)
+	low, high := 100, 200
+	req.LowThresholdCanny, req.HighThresholdCanny = &low, &high
+
+	resp, err := client.ControlNetPreprocess(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ControlNetPreprocess() error = %v", err)
+	}
+	if resp.GuideImageUUID != "guide-1" || resp.GuideImageURL == nil {
+		t.Errorf("response = %+v, want guide image", resp)
+	}
+}
+
+func TestAutoInpaintFaces(t *testing.T) {
+	var tasks []map[string]interface{}
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		tasks = append(tasks, task)
+		switch task["taskType"] {
+		case models.TaskTypeImageMasking:
+			return map[string]interface{}{"data": []map[string]interface{}{{
+				"taskType":       task["taskType"],
+				"taskUUID":       task["taskUUID"],
+				"inputImageUUID": "seed-uuid",
+				"maskImageUUID":  "mask-uuid",
+				"detections":     []map[string]int{{"x_min": 10, "y_min": 20, "x_max": 110, "y_max": 140}},
+			}}}
+		default:
+			return map[string]interface{}{"data": []map[string]interface{}{{
+				"taskType":  task["taskType"],
+				"taskUUID":  task["taskUUID"],
+				"imageUUID": "result",
+			}}}
+		}
+	})
+
+	resp, err := client.AutoInpaintFaces(context.Background(), "smiling", testModel, "https://example.com/seed.png", 512, 512, 0.6)
+	if err != nil {
+		t.Fatalf("AutoInpaintFaces() error = %v", err)
+	}
+	if resp.ImageUUID != "result" {
+		t.Errorf("ImageUUID = %q, want result", resp.ImageUUID)
+	}
+	if len(tasks) != 2 {
+		t.Fatalf("sent %d tasks, want masking then inference", len(tasks))
+	}
+	if tasks[0]["model"] != models.MaskingModelFaceYOLOv8n {
+		t.Errorf("masking model = %v, want face detection", tasks[0]["model"])
+	}
+	if tasks[1]["maskImage"] != "mask-uuid" || tasks[1]["seedImage"] != "seed-uuid" {
+		t.Errorf("inference task = %v, want generated mask and seed", tasks[1])
+	}
+}
+
+func TestAutoInpaintFacesNoDetections(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		return map[string]interface{}{"data": []map[string]interface{}{{
+			"taskType":      task["taskType"],
+			"taskUUID":      task["taskUUID"],
+			"maskImageUUID": "mask-uuid",
+		}}}
+	})
+
+	_, err := client.AutoInpaintFaces(context.Background(), "smiling", testModel, "seed", 512, 512, 0.6)
+	if !errors.Is(err, ErrNoDetections) {
+		t.Errorf("error = %v, want ErrNoDetections", err)
+	}
+}
+
+func TestRequestBuilderIdentityFeatures(t *testing.T) {
+	req := NewRequestBuilder(testPrompt, testModel, 1024, 1024).
+		WithPuLID([]string{"face-1"}, 1.2).
+		WithACEPlusPlusRepainting("ref", "mask", 0.7).
+		Build()
+
+	if req.PuLID == nil || len(req.PuLID.InputImages) != 1 || *req.PuLID.IDWeight != 1.2 {
+		t.Errorf("PuLID = %+v, want one image with weight 1.2", req.PuLID)
+	}
+	if req.ACEPlusPlus == nil || req.ACEPlusPlus.Type != models.ACEPlusPlusTypeRepainting ||
+		req.ACEPlusPlus.InputMasks[0] != "mask" || *req.ACEPlusPlus.RepaintingScale != 0.7 {
+		t.Errorf("ACEPlusPlus = %+v, want repainting with mask", req.ACEPlusPlus)
+	}
+
+	swap := NewRequestBuilder(testPrompt, testModel, 1024, 1024).WithACEPlusPlusFaceSwap("ref").Build()
+	if swap.ACEPlusPlus.Type != models.ACEPlusPlusTypeFaceSwap || swap.ACEPlusPlus.InputMasks != nil {
+		t.Errorf("ACEPlusPlus = %+v, want face swap without masks", swap.ACEPlusPlus)
+	}
+}
+
+func TestPhotoMaker(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		if task["taskType"] != models.TaskTypePhotoMaker || task["style"] != "Cinematic" {
+			t.Errorf("task = %v, want cinematic photoMaker", task)
+		}
+		if images, _ := task["inputImages"].([]interface{}); len(images) != 2 {
+			t.Errorf("inputImages = %v, want 2", task["inputImages"])
+		}
+		return map[string]interface{}{"data": []map[string]interface{}{
+			{"taskType": task["taskType"], "taskUUID": task["taskUUID"], "imageUUID": "img-1"},
+			{"taskType": task["taskType"], "taskUUID": task["taskUUID"], "imageUUID": "img-2"},
+		}}
+	})
+
+	req := models.NewPhotoMakerRequest([]string{"a", "b"}, "portrait of rwre in a forest", testModel, 1024, 1024)
+	style, n := models.PhotoMakerStyleCinematic, 2
+	req.Style, req.NumberResults = &style, &n
+
+	images, err := client.PhotoMakerAll(context.Background(), req)
+	if err != nil {
+		t.Fatalf("PhotoMakerAll() error = %v", err)
+	}
+	if len(images) != 2 || images[1].ImageUUID != "img-2" {
+		t.Errorf("images = %v, want 2 results", images)
+	}
+}
+
+func TestVectorize(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		inputs, _ := task["inputs"].(map[string]interface{})
+		if task["taskType"] != models.TaskTypeVectorize || task["outputFormat"] != "svg" || inputs["image"] != "raster-uuid" {
+			t.Errorf("task = %v, want svg vectorize of raster-uuid", task)
+		}
+		return map[string]interface{}{"data": []map[string]interface{}{{
+			"taskType":  task["taskType"],
+			"taskUUID":  task["taskUUID"],
+			"imageUUID": "svg-uuid",
+			"imageURL":  "https://example.com/image.svg",
+		}}}
+	})
+
+	resp, err := client.Vectorize(context.Background(), models.NewVectorizeRequest("raster-uuid"))
+	if err != nil {
+		t.Fatalf("Vectorize() error = %v", err)
+	}
+	if resp.ImageUUID != "svg-uuid" || resp.ImageURL == nil {
+		t.Errorf("response = %+v, want svg image", resp)
+	}
+}
+
+func TestClientValidatesRequestsBeforeSending(t *testing.T) {
+	var sent int
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		sent++
+		return map[string]interface{}{"data": []interface{}{map[string]interface{}{
+			"taskType":  models.TaskTypeImageInference,
+			"taskUUID":  task["taskUUID"],
+			"imageUUID": "image-uuid",
+		}}}
+	})
+
+	req := models.NewImageInferenceRequest(testPrompt, testModel, 1000, 1024)
+	_, err := client.ImageInference(context.Background(), req)
+	var vErr *models.ValidationError
+	if !errors.Is(err, ErrInvalidRequest) || !errors.As(err, &vErr) {
+		t.Fatalf("ImageInference() error = %v, want ErrInvalidRequest wrapping *models.ValidationError", err)
+	}
+	if !vErr.Has("width") {
+		t.Errorf("ValidationError should report width, got %v", vErr)
+	}
+	if sent != 0 {
+		t.Errorf("invalid request was sent %d times", sent)
+	}
+
+	client.config.DisableValidation = true
+	if _, err := client.ImageInference(context.Background(), req); err != nil {
+		t.Fatalf("ImageInference() with validation disabled error = %v", err)
+	}
+	if sent != 1 {
+		t.Errorf("request was sent %d times, want 1", sent)
+	}
+}
+
+func TestVideoRequestBuilderBuildRejectsUnsupportedSettings(t *testing.T) {
+	builder := NewVideoRequestBuilder(testPrompt, "openai:3@1").
+		WithDuration(8).
+		WithLastFrame("image-uuid")
+	req, err := builder.BuildChecked()
+	var vErr *models.ValidationError
+	if !errors.Is(err, ErrInvalidRequest) || !errors.As(err, &vErr) || !vErr.Has("frameImages") {
+		t.Fatalf("BuildChecked() error = %v, want a frameImages validation error", err)
+	}
+	if req != nil {
+		t.Error("BuildChecked() should not return a request on error")
+	}
+	if builder.Build() == nil {
+		t.Error("Build() should return the request unchecked")
+	}
+
+	req, err = NewVideoRequestBuilder(testPrompt, "openai:3@1").WithDuration(8).BuildChecked()
+	if err != nil {
+		t.Fatalf("BuildChecked() error = %v", err)
+	}
+	if *req.Width != 1280 || *req.Height != 720 {
+		t.Errorf("BuildChecked() resolution = %dx%d, want the model default 1280x720", *req.Width, *req.Height)
+	}
+}
@@ -63,6 +63,13 @@
 //	config.EnableDebugLogging = true
 //	client, err := runware.NewClient(config)
 //
+// For serverless functions or short-lived jobs, the HTTP transport sends each
+// task as a single POST instead of holding a WebSocket connection open:
+//
+//	config := runware.DefaultConfig()
+//	config.Transport = runware.TransportHTTP
+//	client, err := runware.NewClient(config)
+//
 // # Error Handling
 //
 // The SDK provides detailed error types for robust error handling:
@@ -84,6 +91,14 @@
 //	    }
 //	}
 //
+// Retryable failures can be retried automatically by setting a RetryPolicy.
+// Each retry uses a fresh TaskUUID; if every attempt fails a *RetryError
+// wrapping the final error is returned:
+//
+//	config := runware.DefaultConfig()
+//	config.RetryPolicy = runware.DefaultRetryPolicy()
+//	client, err := runware.NewClient(config)
+//
 // # Image Generation
 //
 // ## Simple Text-to-Image
@@ -105,6 +120,27 @@
 //
 //	resp, err := client.ImageInference(ctx, req)
 //
+// ## Multiple Results
+//
+// When NumberResults is greater than 1, use ImageInferenceAll to receive every image.
+// Partial results are returned alongside a *TimeoutError if not all images arrive in time:
+//
+//	req := runware.NewRequestBuilder("sunset", "runware:101@1", 1024, 1024).
+//	    WithNumberResults(4).
+//	    Build()
+//	images, err := client.ImageInferenceAll(ctx, req)
+//
+// To process images as they finish rather than waiting for the slowest one,
+// use ImageInferenceStream:
+//
+//	stream, err := client.ImageInferenceStream(ctx, req)
+//	for img := range stream.Results() {
+//	    // Handle each image as soon as it is delivered
+//	}
+//	if err := stream.Err(); err != nil {
+//	    // The stream ended early (timeout, API error or canceled context)
+//	}
+//
 // ## Batch Processing
 //
 // Generate multiple images efficiently with bounded concurrency:
@@ -118,34 +154,40 @@
 //
 // # Video Generation
 //
-// Video generation is asynchronous. Submit a request, then poll for results:
+// Video generation is asynchronous. Submit a request to get a Job, then wait for the result:
 //
 //	// Submit video generation
-//	resp, err := client.TextToVideo(ctx, "ocean waves at sunset", "klingai:5@3", 5)
+//	req := runware.NewVideoRequestBuilder("ocean waves at sunset", "klingai:5@3").
+//	    WithDuration(5).
+//	    Build()
+//	job, err := client.VideoInferenceAsync(ctx, req)
 //	if err != nil {
 //	    log.Fatal(err)
 //	}
 //
-//	// Poll for completion (max 120 attempts, 15 second intervals)
-//	final, err := client.PollVideoResult(ctx, resp.TaskUUID, 120, 15*time.Second)
-//	if err != nil {
-//	    log.Fatal(err)
+//	// Poll with backoff until the video is ready or ctx is done
+//	final, err := job.Wait(ctx)
+//	var jobErr *runware.JobError
+//	if errors.As(err, &jobErr) {
+//	    log.Fatalf("Video generation failed: %v", jobErr.APIError)
 //	}
 //
 //	fmt.Printf("Video URL: %s\n", *final.VideoURL)
 //
+// Use client.VideoJob(taskUUID) to resume waiting on a task submitted earlier,
+// and Job.WithBackoff to tune the polling interval.
+//
 // # Audio Generation
 //
 // Similar to video, audio generation is asynchronous:
 //
-//	// Submit audio generation
-//	resp, err := client.TextToAudio(ctx, "gentle piano melody", "elevenlabs:1@1", 30)
+//	job, err := client.AudioInferenceAsync(ctx,
+//	    models.NewAudioInferenceRequest("gentle piano melody", "elevenlabs:1@1", 30))
 //	if err != nil {
 //	    log.Fatal(err)
 //	}
 //
-//	// Poll for completion
-//	final, err := client.PollAudioResult(ctx, resp.TaskUUID, 60, 5*time.Second)
+//	final, err := job.Wait(ctx)
 //	if err != nil {
 //	    log.Fatal(err)
 //	}
@@ -170,6 +212,14 @@
 //	}
 //	wg.Wait()
 //
+// To stay under account rate limits, configure client-side admission control.
+// Requests over the limit are queued until admitted or their context is canceled:
+//
+//	config := runware.DefaultConfig()
+//	config.RateLimit = &runware.RateLimit{RequestsPerSecond: 5, MaxInFlight: 10}
+//	client, err := runware.NewClient(config)
+//	// client.QueueDepth() reports how many requests are waiting
+//
 // # Debug Logging
 //
 // Enable debug logging to troubleshoot connection or API issues:
@@ -202,7 +252,7 @@
 //   - examples/text_to_image - Simple image generation
 //   - examples/advanced_generation - Advanced options with builder pattern
 //   - examples/batch_generation - Parallel batch processing
-//   - examples/text_to_video - Video generation with polling
+//   - examples/text_to_video - Video generation with async jobs
 //   - examples/text_to_audio - Audio generation
 //   - examples/utilities - Upscaling, background removal, etc.
 //
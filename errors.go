@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	wsinternal "github.com/Ryank90/runware-go-sdk/internal/ws"
 	models "github.com/Ryank90/runware-go-sdk/models"
 )
 
@@ -22,24 +23,49 @@ var (
 	ErrAlreadyConnected = errors.New("client is already connected")
 
 	// ErrConnectionClosed is returned when the WebSocket connection is closed unexpectedly.
+	// In-flight requests fail with an error wrapping ErrConnectionClosed as soon as the
+	// connection drops or Disconnect is called, unless WSConfig.ResumeSession is enabled.
 	// If auto-reconnect is enabled, the SDK will attempt to reconnect automatically.
-	ErrConnectionClosed = errors.New("connection closed")
+	ErrConnectionClosed = wsinternal.ErrConnectionClosed
 
 	// ErrInvalidAPIKey is returned when the API key is invalid or missing.
+	// Connect also returns an error wrapping ErrInvalidAPIKey when the API rejects authentication.
 	// Ensure RUNWARE_API_KEY is set or provide it in the Config.
-	ErrInvalidAPIKey = errors.New("invalid or missing API key")
+	ErrInvalidAPIKey = wsinternal.ErrInvalidAPIKey
 
 	// ErrTimeout is returned when an operation times out.
 	// Consider increasing RequestTimeout in the Config for longer-running operations.
 	ErrTimeout = errors.New("operation timed out")
 
 	// ErrInvalidRequest is returned when the request parameters are invalid.
-	// Check that all required fields are populated.
+	// Check that all required fields are populated. Requests that fail local
+	// validation return an error wrapping both ErrInvalidRequest and *models.ValidationError.
 	ErrInvalidRequest = errors.New("invalid request")
 
 	// ErrInvalidResponse is returned when the API response cannot be parsed.
 	// This may indicate an API version mismatch or network corruption.
 	ErrInvalidResponse = errors.New("invalid response")
+
+	// ErrNoDetections is returned by AutoInpaintFaces when image masking finds nothing to inpaint.
+	ErrNoDetections = errors.New("no objects detected")
+
+	// ErrInsufficientBalance is returned by EnsureBalance when the account balance is below the required amount.
+	ErrInsufficientBalance = errors.New("insufficient account balance")
+
+	// ErrPayloadTooLarge is returned when a file or reader upload exceeds Config.MaxUploadSize.
+	ErrPayloadTooLarge = errors.New("upload payload too large")
+
+	// ErrNoOutput is returned by a response's Open and Save methods when it carries
+	// no URL or base64 content, e.g. an async acknowledgment.
+	ErrNoOutput = models.ErrNoOutput
+
+	// ErrUnexpectedContentType is returned by a response's Open and Save methods when
+	// the downloaded content is not the expected kind of media.
+	ErrUnexpectedContentType = models.ErrUnexpectedContentType
+
+	// ErrUnsupportedImageFormat is returned by a response's Decode method when no decoder
+	// is registered for the image format. See models.RegisterImageDecoder.
+	ErrUnsupportedImageFormat = models.ErrUnsupportedImageFormat
 )
 
 // APIError represents an error returned by the Runware API with full context.
@@ -175,6 +201,20 @@ func NewAPIError(errResp *models.ErrorResponse) *APIError {
 	}
 }
 
+// wrapTransportError converts error payloads delivered by the transport into *APIError.
+// Other errors are returned unchanged.
+func wrapTransportError(err error) error {
+	var respErr *wsinternal.ResponseError
+	if !errors.As(err, &respErr) {
+		return err
+	}
+	apiErr := NewAPIError(respErr.Response)
+	if len(respErr.Raw) > 0 {
+		apiErr.RawResponse = string(respErr.Raw)
+	}
+	return apiErr
+}
+
 // IsAPIError checks if an error is an APIError
 func IsAPIError(err error) bool {
 	var apiErr *APIError
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	wsinternal "github.com/Ryank90/runware-go-sdk/internal/ws"
 	"github.com/Ryank90/runware-go-sdk/models"
 )
 
@@ -361,3 +362,38 @@ func TestDebugLogger(t *testing.T) {
 	stdLog := &stdLogger{}
 	stdLog.Printf("test message %s", "arg") // Should not panic
 }
+
+func TestWrapTransportError(t *testing.T) {
+	raw := []byte(`{"code":"rateLimitExceeded","message":"Too many requests","taskUUID":"task-1","taskType":"imageInference"}`)
+	err := wrapTransportError(&wsinternal.ResponseError{
+		Response: &models.ErrorResponse{
+			Code:     "rateLimitExceeded",
+			Message:  "Too many requests",
+			TaskUUID: "task-1",
+			TaskType: "imageInference",
+		},
+		Raw: raw,
+	})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("wrapTransportError() = %T, want *APIError", err)
+	}
+	if apiErr.ErrorID != "rateLimitExceeded" {
+		t.Errorf("ErrorID = %q, want rateLimitExceeded", apiErr.ErrorID)
+	}
+	if apiErr.TaskUUID != "task-1" {
+		t.Errorf("TaskUUID = %q, want task-1", apiErr.TaskUUID)
+	}
+	if apiErr.RawResponse != string(raw) {
+		t.Errorf("RawResponse = %q, want %q", apiErr.RawResponse, raw)
+	}
+	if !apiErr.IsRetryable() {
+		t.Error("rateLimitExceeded should be retryable")
+	}
+
+	plain := errors.New("plain")
+	if got := wrapTransportError(plain); got != plain {
+		t.Errorf("wrapTransportError(plain) = %v, want unchanged", got)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	runware "github.com/Ryank90/runware-go-sdk"
 	models "github.com/Ryank90/runware-go-sdk/models"
@@ -72,7 +71,7 @@ func main() {
 		}
 		go func() {
 			fmt.Printf("\nPolling video %d/%d...\n", i+1, len(responses))
-			r, err := client.PollVideoResult(ctx, resp.TaskUUID, 60, 10*time.Second)
+			r, err := client.VideoJob(resp.TaskUUID).Wait(ctx)
 			resultCh <- idxResp{idx: i, resp: r, err: err}
 		}()
 	}
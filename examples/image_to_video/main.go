@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	runware "github.com/Ryank90/runware-go-sdk"
 	"github.com/joho/godotenv"
@@ -58,7 +57,7 @@ func main() {
 	fmt.Printf("\nVideo request submitted: %s\n", response.TaskUUID)
 	fmt.Println("Polling for result...")
 
-	finalResp, err := client.PollVideoResult(ctx, response.TaskUUID, 60, 10*time.Second)
+	finalResp, err := client.VideoJob(response.TaskUUID).Wait(ctx)
 	if err != nil {
 		log.Fatalf("Failed to get video result: %v", err)
 	}
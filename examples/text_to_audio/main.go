@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	runware "github.com/Ryank90/runware-go-sdk"
 	"github.com/joho/godotenv"
@@ -54,7 +53,7 @@ func main() {
 	fmt.Println("Polling for result (this may take 30-60 seconds)...")
 
 	// Poll for the result
-	finalResp, err := client.PollAudioResult(ctx, response.TaskUUID, 60, 5*time.Second)
+	finalResp, err := client.AudioJob(response.TaskUUID).Wait(ctx)
 	if err != nil {
 		log.Fatalf("Failed to get audio result: %v", err)
 	}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	runware "github.com/Ryank90/runware-go-sdk"
 	"github.com/joho/godotenv"
@@ -55,7 +54,7 @@ func main() {
 	fmt.Println("Polling for result (this may take 2-5 minutes)...")
 
 	// Poll for the result
-	finalResp, err := client.PollVideoResult(ctx, response.TaskUUID, 120, 15*time.Second)
+	finalResp, err := client.VideoJob(response.TaskUUID).Wait(ctx)
 	if err != nil {
 		log.Fatalf("Failed to get video result: %v", err)
 	}
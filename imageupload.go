@@ -0,0 +1,202 @@
+package runware
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif" // register the GIF decoder for image.Decode
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+	"strings"
+
+	wsinternal "github.com/Ryank90/runware-go-sdk/internal/ws"
+	models "github.com/Ryank90/runware-go-sdk/models"
+)
+
+// maxDecodePixels bounds the dimensions of images decoded for downsizing, since a
+// small compressed file can declare dimensions that need gigabytes to decode
+const maxDecodePixels = 8192 * 8192
+
+// UploadImageOptions configures UploadImageFromReader
+type UploadImageOptions struct {
+	// MimeType is the image content type (e.g. "image/png").
+	// If empty, it is detected from the content.
+	MimeType string
+
+	// MaxSize limits the number of bytes read from the reader.
+	// Default: 0, which applies Config.MaxUploadSize.
+	MaxSize int64
+
+	// MaxDimension downsizes images whose width or height exceeds it, preserving
+	// the aspect ratio, before they are uploaded. Only PNG, JPEG and GIF images of
+	// up to 8192x8192 pixels can be downsized; GIFs are re-encoded as PNG.
+	// Zero disables downsizing.
+	MaxDimension int
+
+	// JPEGQuality is the quality used when re-encoding downsized JPEGs (1-100).
+	// Default: 90.
+	JPEGQuality int
+}
+
+// UploadImageFromReader uploads an image read from r.
+//
+// The content is base64-encoded straight into the outgoing message, so it is never
+// held in memory. The content type is sniffed to build an ImageDataURI, and uploads
+// larger than the size limit fail with ErrPayloadTooLarge before anything is sent
+// (see Config.MaxUploadSize). Downsizing has to decode the image, so with
+// MaxDimension set the image is read into memory. If opts is nil, defaults are used.
+//
+// Example:
+//
+//	f, _ := os.Open("photo.jpg")
+//	defer f.Close()
+//	resp, err := client.UploadImageFromReader(ctx, f, &runware.UploadImageOptions{
+//	    MaxDimension: 2048, // downsize large photos before uploading
+//	})
+func (c *Client) UploadImageFromReader(ctx context.Context, r io.Reader, opts *UploadImageOptions) (*models.UploadImageResponse, error) {
+	if r == nil {
+		return nil, ErrInvalidRequest
+	}
+	if opts == nil {
+		opts = &UploadImageOptions{}
+	}
+	limit := opts.MaxSize
+	if limit <= 0 {
+		limit = c.maxUploadSize()
+	}
+
+	content, cleanup, err := sizeUpload(r, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	br := bufio.NewReaderSize(content, 512)
+	mimeType := opts.MimeType
+	if mimeType == "" {
+		head, _ := br.Peek(512)
+		mimeType = http.DetectContentType(head)
+	}
+
+	var src io.Reader = br
+	if opts.MaxDimension > 0 && isResizableImage(mimeType) {
+		var data []byte
+		mimeType, data, err = downsize(br, limit, mimeType, opts)
+		if err != nil {
+			return nil, err
+		}
+		src = bytes.NewReader(data)
+	}
+
+	req := models.NewUploadImageRequest()
+	streamed := &wsinternal.StreamedRequest{Task: req, Field: "imageBase64", Content: src}
+	if strings.HasPrefix(mimeType, "image/") {
+		streamed.Field = "imageDataURI"
+		streamed.Prefix = "data:" + mimeType + ";base64,"
+	}
+
+	result, err := c.sendRequest(ctx, streamed)
+	if err != nil {
+		return nil, err
+	}
+	if resp, ok := result.(*models.UploadImageResponse); ok {
+		return resp, nil
+	}
+	return nil, ErrInvalidResponse
+}
+
+// isResizableImage reports whether the standard library can decode and re-encode the type
+func isResizableImage(mimeType string) bool {
+	switch mimeType {
+	case "image/png", "image/jpeg", "image/gif":
+		return true
+	}
+	return false
+}
+
+// downsize reads the image and, if it exceeds opts.MaxDimension, scales it down and
+// re-encodes it. It returns the content type and bytes of the result.
+func downsize(r io.Reader, limit int64, mimeType string, opts *UploadImageOptions) (string, []byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, limit+1))
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read upload: %w", err)
+	}
+	if int64(len(data)) > limit {
+		return "", nil, fmt.Errorf("%w: more than %d bytes", ErrPayloadTooLarge, limit)
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	if cfg.Width <= opts.MaxDimension && cfg.Height <= opts.MaxDimension {
+		return mimeType, data, nil
+	}
+	if int64(cfg.Width)*int64(cfg.Height) > maxDecodePixels {
+		return "", nil, fmt.Errorf("%w: %dx%d image is too large to downsize", ErrPayloadTooLarge, cfg.Width, cfg.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	resized := downsizeImage(img, opts.MaxDimension)
+
+	var buf bytes.Buffer
+	if mimeType == "image/jpeg" {
+		quality := opts.JPEGQuality
+		if quality <= 0 || quality > 100 {
+			quality = 90
+		}
+		err = jpeg.Encode(&buf, resized, &jpeg.Options{Quality: quality})
+	} else {
+		mimeType = "image/png"
+		err = png.Encode(&buf, resized)
+	}
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+	return mimeType, buf.Bytes(), nil
+}
+
+// downsizeImage scales img so that neither side exceeds maxDimension, averaging
+// the source pixels covered by each destination pixel
+func downsizeImage(img image.Image, maxDimension int) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	dw, dh := w, h
+	if w >= h {
+		dw, dh = maxDimension, max(1, h*maxDimension/w)
+	} else {
+		dw, dh = max(1, w*maxDimension/h), maxDimension
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		y0, y1 := b.Min.Y+y*h/dh, b.Min.Y+max((y+1)*h/dh, y*h/dh+1)
+		for x := 0; x < dw; x++ {
+			x0, x1 := b.Min.X+x*w/dw, b.Min.X+max((x+1)*w/dw, x*w/dw+1)
+
+			var r, g, bl, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					c := color.RGBA64Model.Convert(img.At(sx, sy)).(color.RGBA64)
+					r += uint64(c.R)
+					g += uint64(c.G)
+					bl += uint64(c.B)
+					a += uint64(c.A)
+					n++
+				}
+			}
+			dst.SetRGBA(x, y, color.RGBA{
+				R: uint8(r / n >> 8), G: uint8(g / n >> 8), B: uint8(bl / n >> 8), A: uint8(a / n >> 8),
+			})
+		}
+	}
+	return dst
+}
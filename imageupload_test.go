@@ -0,0 +1,194 @@
+package runware
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Ryank90/runware-go-sdk/models"
+)
+
+func uploadImageResponse(task map[string]interface{}) interface{} {
+	return map[string]interface{}{"data": []interface{}{map[string]interface{}{
+		"taskType":  models.TaskTypeImageUpload,
+		"taskUUID":  task["taskUUID"],
+		"imageUUID": "image-uuid",
+	}}}
+}
+
+func testPNG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{R: 200, G: 100, B: 50, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// decodeUploadedImage decodes the PNG data URI sent in an imageUpload task
+func decodeUploadedImage(t *testing.T, task map[string]interface{}) image.Image {
+	t.Helper()
+	dataURI, _ := task["imageDataURI"].(string)
+	encoded, ok := strings.CutPrefix(dataURI, "data:image/png;base64,")
+	if !ok {
+		t.Fatalf("imageDataURI = %.40q, want a PNG data URI", dataURI)
+	}
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return img
+}
+
+func TestUploadImageFromReaderSniffsDataURI(t *testing.T) {
+	data := testPNG(t, 8, 8)
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		want := "data:image/png;base64," + base64.StdEncoding.EncodeToString(data)
+		if task["imageDataURI"] != want {
+			t.Errorf("imageDataURI = %.40v, want %.40v", task["imageDataURI"], want)
+		}
+		if _, ok := task["imageBase64"]; ok {
+			t.Error("imageBase64 should not be set")
+		}
+		return uploadImageResponse(task)
+	})
+
+	resp, err := client.UploadImageFromReader(context.Background(), bytes.NewReader(data), nil)
+	if err != nil {
+		t.Fatalf("UploadImageFromReader() error = %v", err)
+	}
+	if resp.ImageUUID != "image-uuid" {
+		t.Errorf("ImageUUID = %q, want image-uuid", resp.ImageUUID)
+	}
+}
+
+func TestUploadImageFromReaderMaxSize(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		t.Error("oversized upload should not be sent")
+		return uploadImageResponse(task)
+	})
+
+	data := testPNG(t, 8, 8)
+	_, err := client.UploadImageFromReader(context.Background(), bytes.NewReader(data),
+		&UploadImageOptions{MaxSize: int64(len(data) - 1)})
+	if !errors.Is(err, ErrPayloadTooLarge) {
+		t.Errorf("UploadImageFromReader() error = %v, want ErrPayloadTooLarge", err)
+	}
+}
+
+func TestUploadImageFromUnsizedReader(t *testing.T) {
+	data := testPNG(t, 8, 8)
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		if task["imageDataURI"] != "data:image/png;base64,"+base64.StdEncoding.EncodeToString(data) {
+			t.Error("uploaded content does not match the reader")
+		}
+		return uploadImageResponse(task)
+	})
+
+	// A reader that does not report its size is spooled to check the limit
+	unsized := io.MultiReader(bytes.NewReader(data))
+	if _, err := client.UploadImageFromReader(context.Background(), unsized, nil); err != nil {
+		t.Fatalf("UploadImageFromReader() error = %v", err)
+	}
+
+	unsized = io.MultiReader(bytes.NewReader(data))
+	_, err := client.UploadImageFromReader(context.Background(), unsized, &UploadImageOptions{MaxSize: int64(len(data) - 1)})
+	if !errors.Is(err, ErrPayloadTooLarge) {
+		t.Errorf("UploadImageFromReader() error = %v, want ErrPayloadTooLarge", err)
+	}
+}
+
+func TestUploadImageFromReaderDownsizes(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		img := decodeUploadedImage(t, task)
+		if b := img.Bounds(); b.Dx() != 32 || b.Dy() != 16 {
+			t.Errorf("uploaded image is %dx%d, want 32x16", b.Dx(), b.Dy())
+		}
+		if r, g, b, _ := img.At(5, 5).RGBA(); r>>8 != 200 || g>>8 != 100 || b>>8 != 50 {
+			t.Errorf("pixel = (%d, %d, %d), want (200, 100, 50)", r>>8, g>>8, b>>8)
+		}
+		return uploadImageResponse(task)
+	})
+
+	_, err := client.UploadImageFromReader(context.Background(), bytes.NewReader(testPNG(t, 100, 50)),
+		&UploadImageOptions{MaxDimension: 32})
+	if err != nil {
+		t.Fatalf("UploadImageFromReader() error = %v", err)
+	}
+}
+
+func TestUploadImageFromReaderKeepsSmallImages(t *testing.T) {
+	data := testPNG(t, 10, 10)
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		if task["imageDataURI"] != "data:image/png;base64,"+base64.StdEncoding.EncodeToString(data) {
+			t.Error("image within MaxDimension should be uploaded unchanged")
+		}
+		return uploadImageResponse(task)
+	})
+
+	if _, err := client.UploadImageFromReader(context.Background(), bytes.NewReader(data),
+		&UploadImageOptions{MaxDimension: 32}); err != nil {
+		t.Fatalf("UploadImageFromReader() error = %v", err)
+	}
+}
+
+func TestUploadImageFromReaderRejectsDecompressionBomb(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		t.Error("image that is too large to decode should not be sent")
+		return uploadImageResponse(task)
+	})
+
+	// A PNG header declaring 100000x100000 pixels, which would need ~40 GB to decode
+	ihdr := make([]byte, 17)
+	copy(ihdr, "IHDR")
+	binary.BigEndian.PutUint32(ihdr[4:], 100000)
+	binary.BigEndian.PutUint32(ihdr[8:], 100000)
+	ihdr[12], ihdr[13] = 8, 6 // 8-bit RGBA
+	var data bytes.Buffer
+	data.WriteString("\x89PNG\r\n\x1a\n")
+	binary.Write(&data, binary.BigEndian, uint32(len(ihdr)-4))
+	data.Write(ihdr)
+	binary.Write(&data, binary.BigEndian, crc32.ChecksumIEEE(ihdr))
+
+	_, err := client.UploadImageFromReader(context.Background(), &data, &UploadImageOptions{MaxDimension: 1024})
+	if !errors.Is(err, ErrPayloadTooLarge) {
+		t.Errorf("UploadImageFromReader() error = %v, want ErrPayloadTooLarge", err)
+	}
+}
+
+func TestUploadImageFromFileTooLarge(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		t.Error("oversized upload should not be sent")
+		return uploadImageResponse(task)
+	})
+	client.config.MaxUploadSize = 16
+
+	path := filepath.Join(t.TempDir(), "photo.png")
+	if err := os.WriteFile(path, testPNG(t, 8, 8), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.UploadImageFromFile(context.Background(), path); !errors.Is(err, ErrPayloadTooLarge) {
+		t.Errorf("UploadImageFromFile() error = %v, want ErrPayloadTooLarge", err)
+	}
+}
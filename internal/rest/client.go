@@ -0,0 +1,248 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	wsinternal "github.com/Ryank90/runware-go-sdk/internal/ws"
+	models "github.com/Ryank90/runware-go-sdk/models"
+)
+
+const (
+	// DefaultURL is the default HTTP endpoint for the Runware API
+	DefaultURL = "https://api.runware.ai/v1"
+
+	defaultTimeout = 120 * time.Second
+)
+
+// DebugLogger matches the parent package interface for debug logging
+type DebugLogger interface {
+	Printf(format string, v ...interface{})
+}
+
+// HTTPConfig contains HTTP transport configuration options
+type HTTPConfig struct {
+	URL string
+	// Timeout bounds a single HTTP round trip. Request contexts may shorten it.
+	Timeout time.Duration
+	// HTTPClient is the client used for requests. If nil, a client with Timeout is created.
+	HTTPClient *http.Client
+}
+
+// DefaultHTTPConfig returns a default HTTP configuration
+func DefaultHTTPConfig() *HTTPConfig {
+	return &HTTPConfig{
+		URL:     DefaultURL,
+		Timeout: defaultTimeout,
+	}
+}
+
+// Client sends tasks to the Runware API over HTTP.
+//
+// Each Send performs a single POST of the task array and delivers the
+// response items to the handler before returning, so no connection is
+// held open between requests.
+type Client struct {
+	config      *HTTPConfig
+	apiKey      string
+	httpClient  *http.Client
+	mu          sync.RWMutex
+	connected   bool
+	debugLogger DebugLogger
+
+	handlersMu   sync.RWMutex
+	errorHandler wsinternal.ErrorHandler
+}
+
+// NewClient creates a new HTTP transport client
+func NewClient(apiKey string, config *HTTPConfig, debugLogger DebugLogger) *Client {
+	if config == nil {
+		config = DefaultHTTPConfig()
+	}
+	if debugLogger == nil {
+		debugLogger = &noopLogger{}
+	}
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: config.Timeout}
+	}
+	return &Client{
+		config:      config,
+		apiKey:      apiKey,
+		httpClient:  httpClient,
+		debugLogger: debugLogger,
+	}
+}
+
+type noopLogger struct{}
+
+func (n *noopLogger) Printf(string, ...interface{}) {}
+
+// Connect marks the transport ready for use. HTTP requests are stateless,
+// so no connection is established until a task is sent.
+func (c *Client) Connect(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.connected {
+		return fmt.Errorf("already connected")
+	}
+	c.connected = true
+	c.debugLogger.Printf("HTTP transport ready (%s)", c.config.URL)
+	return nil
+}
+
+// Disconnect marks the transport closed and releases idle connections
+func (c *Client) Disconnect() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.connected = false
+	c.httpClient.CloseIdleConnections()
+	return nil
+}
+
+// IsConnected returns whether the transport is ready to send requests
+func (c *Client) IsConnected() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.connected
+}
+
+// SetErrorHandler registers the callback for error payloads that carry no taskUUID
+func (c *Client) SetErrorHandler(h wsinternal.ErrorHandler) {
+	c.handlersMu.Lock()
+	c.errorHandler = h
+	c.handlersMu.Unlock()
+}
+
+// RemoveHandler is a no-op: handlers are only held for the duration of Send
+func (c *Client) RemoveHandler(string) {}
+
+// Send posts a request and delivers every response item to the handler.
+// Transport failures are returned; API errors are delivered to the handler.
+// Errors without a taskUUID go to the error handler, and also fail the task if
+// the response holds nothing else for it, so the request does not wait for a timeout.
+func (c *Client) Send(ctx context.Context, request interface{}, handler wsinternal.ResponseHandler) error {
+	if !c.IsConnected() {
+		return fmt.Errorf("not connected")
+	}
+
+	var taskUUID, taskType string
+	if ti, ok := request.(models.TaskIdentifiable); ok {
+		taskUUID = ti.GetTaskUUID()
+		taskType = ti.GetTaskType()
+	}
+	if taskUUID == "" {
+		return fmt.Errorf("request missing taskUUID")
+	}
+
+	var reqBody io.Reader
+	if streamed, ok := request.(*wsinternal.StreamedRequest); ok {
+		// Stream the body with chunked encoding rather than marshalling it in memory
+		pr, pw := io.Pipe()
+		go func() { pw.CloseWithError(streamed.WriteMessage(pw)) }()
+		defer pr.Close()
+		reqBody = pr
+	} else {
+		data, err := json.Marshal([]interface{}{request})
+		if err != nil {
+			return fmt.Errorf("failed to marshal request: %w", err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	c.debugLogger.Printf("Sending HTTP request: %s (TaskUUID: %s)", taskType, taskUUID)
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.URL, reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+c.apiKey)
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	env, decodeErr := wsinternal.DecodeEnvelope(body)
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		if decodeErr == nil && len(env.Errors) > 0 {
+			return fmt.Errorf("%w: %w", wsinternal.ErrInvalidAPIKey, env.Errors[0])
+		}
+		return fmt.Errorf("%w: HTTP %d", wsinternal.ErrInvalidAPIKey, resp.StatusCode)
+	}
+	if decodeErr != nil {
+		if resp.StatusCode >= http.StatusBadRequest {
+			return fmt.Errorf("HTTP %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+		}
+		return decodeErr
+	}
+
+	c.debugLogger.Printf("HTTP response for %s (TaskUUID: %s): status %d, %d results, %d errors",
+		taskType, taskUUID, resp.StatusCode, len(env.Data), len(env.Errors))
+
+	delivered := false
+	_, isGetResponse := request.(*models.GetResponseRequest)
+	var getResponseItems []json.RawMessage
+	for _, item := range env.Data {
+		itemUUID, result, err := wsinternal.ParseResponseItem(item)
+		if err != nil || itemUUID != taskUUID {
+			continue
+		}
+		if isGetResponse {
+			getResponseItems = append(getResponseItems, item)
+			continue
+		}
+		handler(result, nil)
+		delivered = true
+	}
+	if len(getResponseItems) > 0 {
+		handler(wsinternal.ParseGetResponse(taskUUID, getResponseItems), nil)
+		delivered = true
+	}
+
+	var taskless []*wsinternal.ResponseError
+	for _, respErr := range env.Errors {
+		switch respErr.Response.TaskUUID {
+		case taskUUID:
+			handler(nil, respErr)
+			delivered = true
+		case "":
+			taskless = append(taskless, respErr)
+		}
+	}
+	for _, respErr := range taskless {
+		c.reportError(respErr)
+	}
+	if !delivered && len(taskless) > 0 {
+		handler(nil, taskless[0])
+	}
+
+	if len(env.Data) == 0 && len(env.Errors) == 0 && resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+	return nil
+}
+
+// reportError passes an error to the error handler on its own goroutine, matching
+// the WebSocket transport
+func (c *Client) reportError(err error) {
+	c.handlersMu.RLock()
+	h := c.errorHandler
+	c.handlersMu.RUnlock()
+	if h != nil {
+		go h(err)
+	}
+}
@@ -0,0 +1,205 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	wsinternal "github.com/Ryank90/runware-go-sdk/internal/ws"
+	"github.com/Ryank90/runware-go-sdk/models"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	config := DefaultHTTPConfig()
+	config.URL = server.URL
+	client := NewClient("test-api-key", config, nil)
+	if err := client.Connect(context.Background()); err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	return client
+}
+
+func TestDefaultHTTPConfig(t *testing.T) {
+	config := DefaultHTTPConfig()
+	if config.URL != DefaultURL {
+		t.Errorf("URL = %q, want %q", config.URL, DefaultURL)
+	}
+	if config.Timeout == 0 {
+		t.Error("Timeout is zero")
+	}
+}
+
+func TestSendDeliversAllResults(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer test-api-key" {
+			t.Errorf("Authorization = %q", got)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var tasks []map[string]interface{}
+		if err := json.Unmarshal(body, &tasks); err != nil || len(tasks) != 1 {
+			t.Fatalf("request body = %s, want task array", body)
+		}
+		taskUUID := tasks[0]["taskUUID"]
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": []map[string]interface{}{
+				{"taskType": "imageInference", "taskUUID": taskUUID, "imageUUID": "img-1"},
+				{"taskType": "imageInference", "taskUUID": taskUUID, "imageUUID": "img-2"},
+			},
+		})
+	})
+
+	req := models.NewImageInferenceRequest("test", "model", 512, 512)
+	var got []string
+	err := client.Send(context.Background(), req, func(data interface{}, err error) {
+		if err != nil {
+			t.Errorf("handler error = %v", err)
+			return
+		}
+		got = append(got, data.(*models.ImageInferenceResponse).ImageUUID)
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if len(got) != 2 || got[0] != "img-1" || got[1] != "img-2" {
+		t.Errorf("results = %v, want [img-1 img-2]", got)
+	}
+}
+
+func TestSendDeliversAPIErrors(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"errors": []map[string]interface{}{
+				{"code": "invalidModel", "message": "Unknown model", "parameter": "model"},
+			},
+		})
+	})
+
+	req := models.NewImageInferenceRequest("test", "model", 512, 512)
+	var got error
+	if err := client.Send(context.Background(), req, func(data interface{}, err error) { got = err }); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	var respErr *wsinternal.ResponseError
+	if !errors.As(got, &respErr) || respErr.Response.Code != "invalidModel" {
+		t.Errorf("handler error = %v, want invalidModel ResponseError", got)
+	}
+}
+
+func TestSendUnauthorized(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"errors":[{"code":"invalidApiKey","message":"Invalid API key"}]}`))
+	})
+
+	req := models.NewImageInferenceRequest("test", "model", 512, 512)
+	err := client.Send(context.Background(), req, func(interface{}, error) {})
+	if !errors.Is(err, wsinternal.ErrInvalidAPIKey) {
+		t.Errorf("Send() error = %v, want ErrInvalidAPIKey", err)
+	}
+}
+
+func TestSendWithoutConnect(t *testing.T) {
+	client := NewClient("test-api-key", nil, nil)
+	req := models.NewImageInferenceRequest("test", "model", 512, 512)
+	if err := client.Send(context.Background(), req, func(interface{}, error) {}); err == nil {
+		t.Error("Send() should fail before Connect")
+	}
+}
+
+func TestSendGetResponseDeliversSingleResult(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": []map[string]interface{}{
+				{"taskType": "imageInference", "taskUUID": "task-1", "imageUUID": "img-1"},
+				{"taskType": "imageInference", "taskUUID": "task-1", "imageUUID": "img-2"},
+			},
+		})
+	})
+
+	var got []interface{}
+	err := client.Send(context.Background(), models.NewGetResponseRequest("task-1"), func(data interface{}, err error) {
+		got = append(got, data)
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("handler called %d times, want 1", len(got))
+	}
+	result, ok := got[0].(*models.GetResponseResult)
+	if !ok || len(result.Images) != 2 {
+		t.Errorf("handler data = %#v, want GetResponseResult with 2 images", got[0])
+	}
+}
+
+func TestSendReportsTasklessErrors(t *testing.T) {
+	var withResult bool
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var tasks []map[string]interface{}
+		_ = json.Unmarshal(body, &tasks)
+
+		resp := map[string]interface{}{
+			"errors": []map[string]interface{}{{"code": "unsupportedFeature", "message": "Not available"}},
+		}
+		if withResult {
+			resp["data"] = []map[string]interface{}{
+				{"taskType": "imageInference", "taskUUID": tasks[0]["taskUUID"], "imageUUID": "img-1"},
+			}
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+	reported := make(chan error, 2)
+	client.SetErrorHandler(func(err error) { reported <- err })
+
+	// With a result for the task, the taskless error only goes to the error handler
+	withResult = true
+	var handlerErrs int
+	err := client.Send(context.Background(), models.NewImageInferenceRequest("test", "model", 512, 512),
+		func(_ interface{}, err error) {
+			if err != nil {
+				handlerErrs++
+			}
+		})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if handlerErrs != 0 {
+		t.Errorf("task handler got %d errors, want 0", handlerErrs)
+	}
+	var respErr *wsinternal.ResponseError
+	if err := <-reported; !errors.As(err, &respErr) || respErr.Response.Code != "unsupportedFeature" {
+		t.Errorf("reported error = %v, want unsupportedFeature", err)
+	}
+
+	// Without one, it also fails the task so the request does not wait for a timeout
+	withResult = false
+	err = client.Send(context.Background(), models.NewImageInferenceRequest("test", "model", 512, 512),
+		func(_ interface{}, err error) {
+			if err != nil {
+				handlerErrs++
+			}
+		})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if handlerErrs != 1 {
+		t.Errorf("task handler got %d errors, want 1", handlerErrs)
+	}
+	<-reported
+}
@@ -3,7 +3,9 @@ package ws
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"sync"
 	"time"
 
@@ -44,6 +46,16 @@ type WSConfig struct {
 	EnableAutoReconnect bool
 	ReadBufferSize      int
 	WriteBufferSize     int
+	// ResumeSession presents the connectionSessionUUID from the previous
+	// authentication when reconnecting, so results of tasks submitted before a
+	// connection drop are still delivered to their handlers. In-flight requests
+	// then wait for the reconnect instead of failing with ErrConnectionClosed as
+	// soon as the connection drops. Default: false.
+	ResumeSession bool
+	// ResendOnReconnect keeps idempotent in-flight tasks (such as getResponse)
+	// registered across a connection loss and re-sends them after a successful
+	// automatic reconnect. Other in-flight tasks always fail with ErrConnectionClosed.
+	ResendOnReconnect bool
 }
 
 // DefaultWSConfig returns a default WebSocket configuration
@@ -65,22 +77,50 @@ func DefaultWSConfig() *WSConfig {
 // ResponseHandler handles responses for a specific task
 type ResponseHandler func(data interface{}, err error)
 
+// ErrConnectionClosed is delivered to in-flight handlers when the connection
+// is closed by Disconnect or lost before their responses arrive.
+var ErrConnectionClosed = errors.New("connection closed")
+
+// ErrInvalidAPIKey is returned by Connect when the API rejects the authentication request
+var ErrInvalidAPIKey = errors.New("invalid or missing API key")
+
+// authErrorCodes lists the error codes the API returns for a rejected API key
+var authErrorCodes = map[string]bool{
+	"invalidApiKey":        true,
+	"missingApiKey":        true,
+	"unauthorized":         true,
+	"authenticationFailed": true,
+}
+
+// idempotentTaskTypes lists task types that are safe to re-send after a reconnect
+var idempotentTaskTypes = map[string]bool{
+	models.TaskTypeGetResponse:       true,
+	models.TaskTypeModelSearch:       true,
+	models.TaskTypeAccountManagement: true,
+}
+
 // Client manages the WebSocket connection
 type Client struct {
-	config        *WSConfig
-	apiKey        string
-	conn          *websocket.Conn
-	mu            sync.RWMutex
-	writeMu       sync.Mutex
-	connected     bool
-	stopChan      chan struct{}
-	reconnectChan chan struct{}
-	messageChan   chan []byte
-	errorChan     chan error
-	handlers      map[string]ResponseHandler
-	handlersMu    sync.RWMutex
-	wg            sync.WaitGroup
-	debugLogger   DebugLogger
+	config         *WSConfig
+	apiKey         string
+	conn           *websocket.Conn
+	mu             sync.RWMutex
+	writeMu        sync.Mutex
+	lifecycleMu    sync.Mutex // serialises Connect and Disconnect, held until shutdown finishes
+	connected      bool
+	started        bool
+	sessionUUID    string
+	stopChan       chan struct{}
+	reconnectChan  chan struct{}
+	messageChan    chan []byte
+	errorChan      chan error
+	handlers       map[string]ResponseHandler
+	requests       map[string]interface{}
+	awaitingResend []string
+	errorHandler   ErrorHandler
+	handlersMu     sync.RWMutex
+	wg             sync.WaitGroup
+	debugLogger    DebugLogger
 }
 
 // NewClient creates a new WebSocket client
@@ -99,6 +139,7 @@ func NewClient(apiKey string, config *WSConfig, debugLogger DebugLogger) *Client
 		messageChan:   make(chan []byte, 100),
 		errorChan:     make(chan error, 10),
 		handlers:      make(map[string]ResponseHandler),
+		requests:      make(map[string]interface{}),
 		debugLogger:   debugLogger,
 	}
 }
@@ -109,12 +150,45 @@ func (n *noopLogger) Printf(string, ...interface{}) {}
 
 // Connect establishes a WebSocket connection
 func (c *Client) Connect(ctx context.Context) error {
+	c.lifecycleMu.Lock()
+	defer c.lifecycleMu.Unlock()
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	if c.connected {
 		return fmt.Errorf("already connected")
 	}
 
+	// A previous Disconnect closes stopChan; start a fresh session
+	if !c.started {
+		select {
+		case <-c.stopChan:
+			c.stopChan = make(chan struct{})
+		default:
+		}
+	}
+
+	if _, err := c.dial(ctx); err != nil {
+		return err
+	}
+
+	if !c.started {
+		c.started = true
+		c.wg.Add(2)
+		go c.processMessages()
+		go c.logErrorsLoop()
+
+		if c.config.EnableAutoReconnect {
+			c.wg.Add(1)
+			go c.reconnectLoop()
+		}
+	}
+	c.startConnectionLoops()
+	return nil
+}
+
+// dial opens and authenticates a new connection, reporting whether the previous
+// session was resumed. The caller must hold c.mu.
+func (c *Client) dial(ctx context.Context) (bool, error) {
 	c.debugLogger.Printf("Connecting to %s", c.config.URL)
 
 	dialer := websocket.Dialer{
@@ -128,7 +202,7 @@ func (c *Client) Connect(ctx context.Context) error {
 		_ = resp.Body.Close()
 	}
 	if err != nil {
-		return fmt.Errorf("failed to connect: %w", err)
+		return false, fmt.Errorf("failed to connect: %w", err)
 	}
 
 	c.conn = conn
@@ -139,66 +213,81 @@ func (c *Client) Connect(ctx context.Context) error {
 	// Set initial read deadline and pong handler
 	if err := c.conn.SetReadDeadline(time.Now().Add(c.config.PongTimeout)); err == nil {
 		c.conn.SetPongHandler(func(string) error {
-			return c.conn.SetReadDeadline(time.Now().Add(c.config.PongTimeout))
+			return conn.SetReadDeadline(time.Now().Add(c.config.PongTimeout))
 		})
 	}
 
-	if err := c.authenticate(); err != nil {
+	previousSession := c.sessionUUID
+	sessionUUID, err := c.authenticate(ctx)
+	if err != nil {
 		_ = c.conn.Close()
+		c.conn = nil
 		c.connected = false
-		return fmt.Errorf("authentication failed: %w", err)
+		return false, fmt.Errorf("authentication failed: %w", err)
 	}
+	c.sessionUUID = sessionUUID
 
-	c.debugLogger.Printf("Authenticated successfully")
+	resumed := previousSession != "" && sessionUUID == previousSession
+	c.debugLogger.Printf("Authenticated successfully (session: %s, resumed: %v)", sessionUUID, resumed)
+	return resumed, nil
+}
 
-	c.wg.Add(4)
-	go c.readLoop()
-	go c.processMessages()
-	go c.pingLoop()
-	go c.logErrorsLoop()
+// SessionUUID returns the connectionSessionUUID assigned by the API on authentication
+func (c *Client) SessionUUID() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.sessionUUID
+}
 
-	if c.config.EnableAutoReconnect {
-		c.wg.Add(1)
-		go c.reconnectLoop(ctx)
-	}
-	return nil
+// startConnectionLoops starts the goroutines bound to the current connection.
+// The caller must hold c.mu.
+func (c *Client) startConnectionLoops() {
+	done := make(chan struct{})
+	c.wg.Add(2)
+	go c.readLoop(c.conn, done)
+	go c.pingLoop(c.conn, done)
 }
 
-// Disconnect closes the WebSocket connection
+// Disconnect closes the WebSocket connection and fails any in-flight requests.
+// It returns once the connection goroutines have exited, so no response is
+// delivered after it returns and a following Connect starts from a clean state.
 func (c *Client) Disconnect() error {
+	c.lifecycleMu.Lock()
+	defer c.lifecycleMu.Unlock()
+
 	c.mu.Lock()
-	if !c.connected {
+	if !c.connected && !c.started {
 		c.mu.Unlock()
 		return nil
 	}
 	c.connected = false
+	c.started = false
 	select {
 	case <-c.stopChan:
 	default:
 		close(c.stopChan)
 	}
+	conn := c.conn
+	c.conn = nil
+	c.sessionUUID = ""
 	c.mu.Unlock()
 
-	done := make(chan struct{})
-	go func() {
-		c.wg.Wait()
-		close(done)
-	}()
-
-	select {
-	case <-done:
-	case <-time.After(5 * time.Second):
+	var err error
+	if conn != nil {
+		// WriteControl does not wait for writeMu, so an in-flight write cannot
+		// hold up the close, and closing the connection aborts that write
+		err = conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+			time.Now().Add(c.config.WriteTimeout))
+		_ = conn.Close()
 	}
 
-	c.mu.Lock()
-	defer c.mu.Unlock()
-	if c.conn != nil {
-		err := c.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
-		_ = c.conn.Close()
-		c.conn = nil
-		return err
-	}
-	return nil
+	// Every goroutine exits on stopChan or the closed connection, so this does
+	// not need a timeout
+	c.wg.Wait()
+
+	c.failPending(nil)
+	return err
 }
 
 // IsConnected returns whether the client is connected
@@ -214,9 +303,15 @@ func (c *Client) Send(ctx context.Context, request interface{}, handler Response
 		return fmt.Errorf("not connected")
 	}
 
-	data, err := json.Marshal([]interface{}{request})
-	if err != nil {
-		return fmt.Errorf("failed to marshal request: %w", err)
+	// Streamed requests are encoded while they are written
+	streamed, isStreamed := request.(*StreamedRequest)
+	var data []byte
+	if !isStreamed {
+		var err error
+		data, err = json.Marshal([]interface{}{request})
+		if err != nil {
+			return fmt.Errorf("failed to marshal request: %w", err)
+		}
 	}
 
 	// Extract task fields via optional interface to avoid extra JSON work
@@ -233,8 +328,26 @@ func (c *Client) Send(ctx context.Context, request interface{}, handler Response
 
 	c.handlersMu.Lock()
 	c.handlers[taskUUID] = handler
+	c.requests[taskUUID] = request
 	c.handlersMu.Unlock()
 
+	var err error
+	if isStreamed {
+		err = c.writeStream(streamed.WriteMessage)
+	} else {
+		err = c.write(data)
+	}
+	if err != nil {
+		c.removeHandler(taskUUID)
+		return err
+	}
+
+	c.debugLogger.Printf("Request sent successfully: %s (TaskUUID: %s)", taskType, taskUUID)
+	return nil
+}
+
+// write sends a text frame on the current connection
+func (c *Client) write(data []byte) error {
 	c.mu.RLock()
 	conn := c.conn
 	c.mu.RUnlock()
@@ -250,46 +363,287 @@ func (c *Client) Send(ctx context.Context, request interface{}, handler Response
 	if err := conn.WriteMessage(websocket.TextMessage, data); err != nil {
 		return fmt.Errorf("failed to send message: %w", err)
 	}
+	return nil
+}
 
-	c.debugLogger.Printf("Request sent successfully: %s (TaskUUID: %s)", taskType, taskUUID)
+// writeStream sends a text message produced by fn in chunks. The write deadline is
+// extended for each chunk, so large messages are not cut off by WriteTimeout.
+func (c *Client) writeStream(fn func(io.Writer) error) error {
+	c.mu.RLock()
+	conn := c.conn
+	c.mu.RUnlock()
+	if conn == nil {
+		return fmt.Errorf("not connected")
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if err := conn.SetWriteDeadline(time.Now().Add(c.config.WriteTimeout)); err != nil {
+		return fmt.Errorf("failed to set write deadline: %w", err)
+	}
+	w, err := conn.NextWriter(websocket.TextMessage)
+	if err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+	err = fn(&deadlineWriter{conn: conn, w: w, timeout: c.config.WriteTimeout})
+	_ = conn.SetWriteDeadline(time.Now().Add(c.config.WriteTimeout))
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
 	return nil
 }
 
-func (c *Client) authenticate() error {
-	authMsg := []map[string]interface{}{
-		{"taskType": "authentication", "apiKey": c.apiKey},
+// deadlineWriter extends the connection's write deadline before each write
+type deadlineWriter struct {
+	conn    *websocket.Conn
+	w       io.Writer
+	timeout time.Duration
+}
+
+func (d *deadlineWriter) Write(p []byte) (int, error) {
+	if err := d.conn.SetWriteDeadline(time.Now().Add(d.timeout)); err != nil {
+		return 0, err
+	}
+	return d.w.Write(p)
+}
+
+// authenticate sends the authentication task and waits for the API to accept it,
+// returning the connectionSessionUUID. The caller must hold c.mu.
+func (c *Client) authenticate(ctx context.Context) (string, error) {
+	authTask := map[string]interface{}{"taskType": "authentication", "apiKey": c.apiKey}
+	if c.config.ResumeSession && c.sessionUUID != "" {
+		authTask["connectionSessionUUID"] = c.sessionUUID
 	}
-	data, err := json.Marshal(authMsg)
+	data, err := json.Marshal([]map[string]interface{}{authTask})
 	if err != nil {
-		return err
+		return "", err
 	}
+
 	c.writeMu.Lock()
-	defer c.writeMu.Unlock()
-	if err := c.conn.SetWriteDeadline(time.Now().Add(c.config.WriteTimeout)); err != nil {
-		return err
+	err = c.conn.SetWriteDeadline(time.Now().Add(c.config.WriteTimeout))
+	if err == nil {
+		err = c.conn.WriteMessage(websocket.TextMessage, data)
 	}
-	return c.conn.WriteMessage(websocket.TextMessage, data)
+	c.writeMu.Unlock()
+	if err != nil {
+		return "", err
+	}
+
+	deadline := time.Now().Add(c.config.ConnectTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := c.conn.SetReadDeadline(deadline); err != nil {
+		return "", err
+	}
+	defer func() { _ = c.conn.SetReadDeadline(time.Now().Add(c.config.PongTimeout)) }()
+
+	for {
+		_, message, err := c.conn.ReadMessage()
+		if err != nil {
+			return "", fmt.Errorf("no authentication response: %w", err)
+		}
+		sessionUUID, found, err := parseAuthResponse(message)
+		if err != nil {
+			return "", err
+		}
+		if found {
+			return sessionUUID, nil
+		}
+		// Not an authentication reply (e.g. a result for a resumed task); process it normally
+		select {
+		case c.messageChan <- message:
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-c.stopChan:
+			return "", ErrConnectionClosed
+		}
+	}
+}
+
+// parseAuthResponse extracts the connectionSessionUUID from an authentication reply.
+// found is false when the message is unrelated to authentication.
+func parseAuthResponse(message []byte) (sessionUUID string, found bool, err error) {
+	env, err := DecodeEnvelope(message)
+	if err != nil {
+		return "", false, err
+	}
+	for _, respErr := range env.Errors {
+		if respErr.Response.TaskUUID != "" {
+			continue
+		}
+		// Other taskless errors (rate limits, malformed requests) also fail the
+		// handshake, but must not be reported as a bad API key
+		if authErrorCodes[respErr.Response.Code] {
+			return "", true, fmt.Errorf("%w: %w", ErrInvalidAPIKey, respErr)
+		}
+		return "", true, respErr
+	}
+
+	var flat struct {
+		ConnectionSessionUUID string `json:"connectionSessionUUID"`
+	}
+	if err := json.Unmarshal(message, &flat); err == nil && flat.ConnectionSessionUUID != "" {
+		return flat.ConnectionSessionUUID, true, nil
+	}
+
+	for _, raw := range env.Data {
+		var item struct {
+			TaskType              string `json:"taskType"`
+			ConnectionSessionUUID string `json:"connectionSessionUUID"`
+		}
+		if err := json.Unmarshal(raw, &item); err != nil {
+			continue
+		}
+		if item.TaskType == "authentication" || item.ConnectionSessionUUID != "" {
+			if item.ConnectionSessionUUID == "" {
+				return "", true, fmt.Errorf("authentication response missing connectionSessionUUID")
+			}
+			return item.ConnectionSessionUUID, true, nil
+		}
+	}
+	return "", false, nil
 }
 
-func (c *Client) readLoop() {
+func (c *Client) readLoop(conn *websocket.Conn, done chan struct{}) {
 	defer c.wg.Done()
+	defer close(done)
 	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			select {
+			case <-c.stopChan:
+				// Closed intentionally by Disconnect
+				return
+			default:
+			}
+			select {
+			case c.errorChan <- fmt.Errorf("read error: %w", err):
+			default:
+			}
+			c.handleConnectionLoss(conn)
+			return
+		}
+		_ = conn.SetReadDeadline(time.Now().Add(c.config.PongTimeout))
 		select {
+		case c.messageChan <- message:
 		case <-c.stopChan:
 			return
-		default:
-			_, message, err := c.conn.ReadMessage()
-			if err != nil {
-				c.errorChan <- fmt.Errorf("read error: %w", err)
-				c.triggerReconnect()
-				return
-			}
-			_ = c.conn.SetReadDeadline(time.Now().Add(c.config.PongTimeout))
-			c.messageChan <- message
 		}
 	}
 }
 
+// handleConnectionLoss tears down a dropped connection and schedules a reconnect.
+// When the session can be resumed, in-flight handlers stay registered so their
+// results are delivered after reconnecting; otherwise they fail immediately.
+func (c *Client) handleConnectionLoss(conn *websocket.Conn) {
+	c.mu.Lock()
+	if c.conn == conn {
+		c.connected = false
+		c.conn = nil
+	}
+	resumable := c.config.EnableAutoReconnect && c.config.ResumeSession && c.sessionUUID != ""
+	c.mu.Unlock()
+	_ = conn.Close()
+
+	if !resumable {
+		c.failUnresumable()
+	}
+
+	c.triggerReconnect()
+}
+
+// failUnresumable fails in-flight requests that cannot survive a connection loss.
+// Idempotent tasks are kept for re-sending when enabled.
+func (c *Client) failUnresumable() {
+	var keep func(request interface{}) bool
+	if c.config.EnableAutoReconnect && c.config.ResendOnReconnect {
+		keep = isIdempotentRequest
+	}
+	kept := c.failPending(keep)
+
+	c.handlersMu.Lock()
+	c.awaitingResend = kept
+	c.handlersMu.Unlock()
+}
+
+// failPending fails registered handlers with ErrConnectionClosed. Handlers whose
+// request satisfies keep stay registered; their task UUIDs are returned.
+func (c *Client) failPending(keep func(request interface{}) bool) []string {
+	type failed struct {
+		handler ResponseHandler
+		err     error
+	}
+	var toFail []failed
+	var kept []string
+
+	c.handlersMu.Lock()
+	for taskUUID, h := range c.handlers {
+		request := c.requests[taskUUID]
+		if keep != nil && keep(request) {
+			kept = append(kept, taskUUID)
+			continue
+		}
+		taskType := "task"
+		if ti, ok := request.(models.TaskIdentifiable); ok && ti.GetTaskType() != "" {
+			taskType = ti.GetTaskType()
+		}
+		toFail = append(toFail, failed{
+			handler: h,
+			err:     fmt.Errorf("%s (TaskUUID: %s): %w", taskType, taskUUID, ErrConnectionClosed),
+		})
+		delete(c.handlers, taskUUID)
+		delete(c.requests, taskUUID)
+	}
+	c.awaitingResend = nil
+	c.handlersMu.Unlock()
+
+	for _, f := range toFail {
+		f.handler(nil, f.err)
+	}
+	if len(toFail) > 0 {
+		c.debugLogger.Printf("Failed %d in-flight requests: connection closed", len(toFail))
+	}
+	return kept
+}
+
+// resendPending re-sends tasks kept across a reconnect
+func (c *Client) resendPending() {
+	c.handlersMu.Lock()
+	pending := c.awaitingResend
+	c.awaitingResend = nil
+	c.handlersMu.Unlock()
+
+	for _, taskUUID := range pending {
+		c.handlersMu.RLock()
+		request, ok := c.requests[taskUUID]
+		h := c.handlers[taskUUID]
+		c.handlersMu.RUnlock()
+		if !ok {
+			continue
+		}
+
+		data, err := json.Marshal([]interface{}{request})
+		if err == nil {
+			err = c.write(data)
+		}
+		if err != nil {
+			c.removeHandler(taskUUID)
+			h(nil, fmt.Errorf("resend after reconnect (TaskUUID: %s): %w", taskUUID, ErrConnectionClosed))
+			continue
+		}
+		c.debugLogger.Printf("Re-sent request after reconnect (TaskUUID: %s)", taskUUID)
+	}
+}
+
+func isIdempotentRequest(request interface{}) bool {
+	ti, ok := request.(models.TaskIdentifiable)
+	return ok && idempotentTaskTypes[ti.GetTaskType()]
+}
+
 func (c *Client) processMessages() {
 	defer c.wg.Done()
 	for {
@@ -302,87 +656,264 @@ func (c *Client) processMessages() {
 	}
 }
 
-// The message handler and routing are kept here for simplicity
-func (c *Client) handleMessage(message []byte) {
+// ResponseError carries an error payload returned by the API.
+// The parent package converts it into its public APIError type.
+type ResponseError struct {
+	Response *models.ErrorResponse
+	Raw      []byte
+}
+
+// Error implements the error interface
+func (e *ResponseError) Error() string {
+	msg := e.Response.Error
+	if msg == "" {
+		msg = e.Response.Message
+	}
+	code := e.Response.ErrorID
+	if code == "" {
+		code = e.Response.Code
+	}
+	if code != "" {
+		return fmt.Sprintf("api error: %s (%s)", msg, code)
+	}
+	return fmt.Sprintf("api error: %s", msg)
+}
+
+// ErrorHandler receives errors that cannot be routed to a task handler,
+// such as authentication failures or malformed requests without a taskUUID.
+// It is called on its own goroutine so it cannot stall message processing,
+// but it must not block indefinitely.
+type ErrorHandler func(err error)
+
+// SetErrorHandler registers a connection-level error callback
+func (c *Client) SetErrorHandler(h ErrorHandler) {
+	c.handlersMu.Lock()
+	c.errorHandler = h
+	c.handlersMu.Unlock()
+}
+
+// Envelope is the decoded form of a message received from the API
+type Envelope struct {
+	Data   []json.RawMessage
+	Errors []*ResponseError
+}
+
+// DecodeEnvelope splits an API message into response items and error payloads.
+// Both the {"errors":[...]} array and the single-object "error" envelopes are supported.
+func DecodeEnvelope(message []byte) (*Envelope, error) {
 	var response struct {
-		Data []json.RawMessage `json:"data,omitempty"`
+		Data   []json.RawMessage `json:"data,omitempty"`
+		Errors []json.RawMessage `json:"errors,omitempty"`
+		Error  json.RawMessage   `json:"error,omitempty"`
 	}
 	if err := json.Unmarshal(message, &response); err != nil {
-		c.handleErrorResponse(message)
+		return nil, fmt.Errorf("malformed message: %w", err)
+	}
+
+	env := &Envelope{Data: response.Data}
+	errItems := response.Errors
+	if len(response.Error) > 0 {
+		// The single-object envelope either nests the error object under "error"
+		// or places the error fields at the top level alongside an "error" message.
+		if response.Error[0] == '{' {
+			errItems = append(errItems, response.Error)
+		} else {
+			errItems = append(errItems, message)
+		}
+	}
+
+	for _, item := range errItems {
+		var errResp models.ErrorResponse
+		if err := json.Unmarshal(item, &errResp); err != nil {
+			return nil, fmt.Errorf("malformed error response: %w", err)
+		}
+		env.Errors = append(env.Errors, &ResponseError{Response: &errResp, Raw: item})
+	}
+	return env, nil
+}
+
+// ParseResponseItem decodes a single response item into its typed response,
+// returning the task UUID it belongs to
+func ParseResponseItem(item json.RawMessage) (string, interface{}, error) {
+	var baseResp struct {
+		TaskUUID string `json:"taskUUID"`
+		TaskType string `json:"taskType"`
+		Status   string `json:"status,omitempty"`
+	}
+	if err := json.Unmarshal(item, &baseResp); err != nil {
+		return "", nil, fmt.Errorf("malformed response item: %w", err)
+	}
+	return baseResp.TaskUUID, parseResponseByType(baseResp.TaskType, item), nil
+}
+
+// The message handler and routing are kept here for simplicity
+func (c *Client) handleMessage(message []byte) {
+	env, err := DecodeEnvelope(message)
+	if err != nil {
+		c.reportConnectionError(err)
 		return
 	}
 
-	for _, item := range response.Data {
+	var getResponses map[string][]json.RawMessage
+	for _, item := range env.Data {
+		taskUUID, isGetResponse := c.getResponseTask(item)
+		if isGetResponse {
+			if getResponses == nil {
+				getResponses = make(map[string][]json.RawMessage)
+			}
+			getResponses[taskUUID] = append(getResponses[taskUUID], item)
+			continue
+		}
 		c.processResponseItem(item)
 	}
-}
 
-func (c *Client) handleErrorResponse(message []byte) {
-	var errResp struct {
-		Error    string `json:"error"`
-		ErrorID  string `json:"errorId,omitempty"`
-		Code     string `json:"code,omitempty"`
-		Message  string `json:"message,omitempty"`
-		TaskUUID string `json:"taskUUID,omitempty"`
-		TaskType string `json:"taskType,omitempty"`
+	for taskUUID, items := range getResponses {
+		c.processGetResponse(taskUUID, items)
 	}
-	if json.Unmarshal(message, &errResp) == nil && errResp.TaskUUID != "" {
+
+	for _, respErr := range env.Errors {
+		c.handleErrorResponse(respErr)
+	}
+}
+
+func (c *Client) handleErrorResponse(respErr *ResponseError) {
+	if taskUUID := respErr.Response.TaskUUID; taskUUID != "" {
 		c.handlersMu.RLock()
-		h, ok := c.handlers[errResp.TaskUUID]
+		h, ok := c.handlers[taskUUID]
 		c.handlersMu.RUnlock()
 		if ok {
-			h(nil, fmt.Errorf("api error: %s", errResp.Error))
-			c.removeHandler(errResp.TaskUUID)
+			c.removeHandler(taskUUID)
+			h(nil, respErr)
+			return
 		}
 	}
+
+	c.reportConnectionError(respErr)
 }
 
-func (c *Client) processResponseItem(item json.RawMessage) {
-	var baseResp struct {
-		TaskUUID string `json:"taskUUID"`
-		TaskType string `json:"taskType"`
-		Status   string `json:"status,omitempty"`
+func (c *Client) reportConnectionError(err error) {
+	c.handlersMu.RLock()
+	h := c.errorHandler
+	c.handlersMu.RUnlock()
+	if h != nil {
+		// Run the user's handler off the dispatch goroutine so a slow handler
+		// cannot hold up responses for other tasks
+		go h(err)
 	}
-	if err := json.Unmarshal(item, &baseResp); err != nil {
+	select {
+	case c.errorChan <- err:
+	default:
+	}
+}
+
+func (c *Client) processResponseItem(item json.RawMessage) {
+	taskUUID, result, err := ParseResponseItem(item)
+	if err != nil {
 		return
 	}
 
 	c.handlersMu.RLock()
-	h, ok := c.handlers[baseResp.TaskUUID]
+	h, ok := c.handlers[taskUUID]
 	c.handlersMu.RUnlock()
 	if !ok {
 		return
 	}
 
-	result := c.parseResponseByType(baseResp.TaskType, item)
 	h(result, nil)
 }
 
-func (c *Client) parseResponseByType(taskType string, item json.RawMessage) interface{} {
+// getResponseTask reports whether an item answers a pending getResponse request
+func (c *Client) getResponseTask(item json.RawMessage) (string, bool) {
+	var base struct {
+		TaskUUID string `json:"taskUUID"`
+	}
+	if err := json.Unmarshal(item, &base); err != nil || base.TaskUUID == "" {
+		return "", false
+	}
+	c.handlersMu.RLock()
+	_, ok := c.requests[base.TaskUUID].(*models.GetResponseRequest)
+	c.handlersMu.RUnlock()
+	return base.TaskUUID, ok
+}
+
+// processGetResponse delivers every item of a getResponse reply as a single result
+func (c *Client) processGetResponse(taskUUID string, items []json.RawMessage) {
+	c.handlersMu.RLock()
+	h, ok := c.handlers[taskUUID]
+	c.handlersMu.RUnlock()
+	if !ok {
+		return
+	}
+
+	h(ParseGetResponse(taskUUID, items), nil)
+}
+
+// ParseGetResponse collects the items returned for a getResponse request into a
+// result typed by each item's taskType. Items of unsupported task types are kept raw.
+func ParseGetResponse(taskUUID string, items []json.RawMessage) *models.GetResponseResult {
+	result := &models.GetResponseResult{TaskUUID: taskUUID}
+	for _, item := range items {
+		var base struct {
+			TaskType string `json:"taskType"`
+		}
+		if err := json.Unmarshal(item, &base); err != nil {
+			result.Unknown = append(result.Unknown, item)
+			continue
+		}
+		if !result.Add(parseResponseByType(base.TaskType, item)) {
+			result.Unknown = append(result.Unknown, item)
+			continue
+		}
+		if result.TaskType == "" {
+			result.TaskType = base.TaskType
+		}
+	}
+	return result
+}
+
+func parseResponseByType(taskType string, item json.RawMessage) interface{} {
 	switch taskType {
 	case models.TaskTypeImageInference:
-		return c.parseImageInferenceResponse(item)
+		return parseImageInferenceResponse(item)
 	case models.TaskTypeImageUpload:
-		return c.parseUploadImageResponse(item)
+		return parseUploadImageResponse(item)
+	case models.TaskTypeMediaStorage:
+		return parseMediaUploadResponse(item)
 	case models.TaskTypeUpscaleGan:
-		return c.parseUpscaleGanResponse(item)
+		return parseUpscaleGanResponse(item)
 	case models.TaskTypeImageBackgroundRemoval:
-		return c.parseRemoveBackgroundResponse(item)
+		return parseRemoveBackgroundResponse(item)
 	case models.TaskTypePromptEnhance:
-		return c.parseEnhancePromptResponse(item)
+		return parseEnhancePromptResponse(item)
 	case models.TaskTypeImageCaption:
-		return c.parseImageCaptionResponse(item)
+		return parseImageCaptionResponse(item)
+	case models.TaskTypeControlNetPreprocess:
+		return parseControlNetPreprocessResponse(item)
+	case models.TaskTypeImageMasking:
+		return parseImageMaskingResponse(item)
+	case models.TaskTypePhotoMaker:
+		return parsePhotoMakerResponse(item)
+	case models.TaskTypeVectorize:
+		return parseVectorizeResponse(item)
 	case models.TaskTypeVideoInference:
-		return c.parseVideoInferenceResponse(item)
+		return parseVideoInferenceResponse(item)
 	case models.TaskTypeAudioInference:
-		return c.parseAudioInferenceResponse(item)
-	case models.TaskTypeGetResponse:
-		return c.parseGetResponse(item)
+		return parseAudioInferenceResponse(item)
+	case models.TaskTypeVideoUpscale:
+		return parseVideoUpscaleResponse(item)
+	case models.TaskTypeVideoBackgroundRemoval:
+		return parseVideoBackgroundRemovalResponse(item)
+	case models.TaskTypeModelSearch:
+		return parseModelSearchResponse(item)
+	case models.TaskTypeModelUpload:
+		return parseModelUploadResponse(item)
+	case models.TaskTypeAccountManagement:
+		return parseAccountManagementResponse(item)
 	}
 	return nil
 }
 
-func (c *Client) parseImageInferenceResponse(item json.RawMessage) interface{} {
+func parseImageInferenceResponse(item json.RawMessage) interface{} {
 	var resp models.ImageInferenceResponse
 	if err := json.Unmarshal(item, &resp); err == nil {
 		return &resp
@@ -390,7 +921,15 @@ func (c *Client) parseImageInferenceResponse(item json.RawMessage) interface{} {
 	return nil
 }
 
-func (c *Client) parseUploadImageResponse(item json.RawMessage) interface{} {
+func parseMediaUploadResponse(item json.RawMessage) interface{} {
+	var resp models.MediaUploadResponse
+	if err := json.Unmarshal(item, &resp); err == nil {
+		return &resp
+	}
+	return nil
+}
+
+func parseUploadImageResponse(item json.RawMessage) interface{} {
 	var resp models.UploadImageResponse
 	if err := json.Unmarshal(item, &resp); err == nil {
 		return &resp
@@ -398,7 +937,7 @@ func (c *Client) parseUploadImageResponse(item json.RawMessage) interface{} {
 	return nil
 }
 
-func (c *Client) parseUpscaleGanResponse(item json.RawMessage) interface{} {
+func parseUpscaleGanResponse(item json.RawMessage) interface{} {
 	var resp models.UpscaleGanResponse
 	if err := json.Unmarshal(item, &resp); err == nil {
 		return &resp
@@ -406,7 +945,7 @@ func (c *Client) parseUpscaleGanResponse(item json.RawMessage) interface{} {
 	return nil
 }
 
-func (c *Client) parseRemoveBackgroundResponse(item json.RawMessage) interface{} {
+func parseRemoveBackgroundResponse(item json.RawMessage) interface{} {
 	var resp models.RemoveImageBackgroundResponse
 	if err := json.Unmarshal(item, &resp); err == nil {
 		return &resp
@@ -414,7 +953,7 @@ func (c *Client) parseRemoveBackgroundResponse(item json.RawMessage) interface{}
 	return nil
 }
 
-func (c *Client) parseEnhancePromptResponse(item json.RawMessage) interface{} {
+func parseEnhancePromptResponse(item json.RawMessage) interface{} {
 	var resp models.EnhancePromptResponse
 	if err := json.Unmarshal(item, &resp); err == nil {
 		return &resp
@@ -422,7 +961,7 @@ func (c *Client) parseEnhancePromptResponse(item json.RawMessage) interface{} {
 	return nil
 }
 
-func (c *Client) parseImageCaptionResponse(item json.RawMessage) interface{} {
+func parseImageCaptionResponse(item json.RawMessage) interface{} {
 	var resp models.ImageCaptionResponse
 	if err := json.Unmarshal(item, &resp); err == nil {
 		return &resp
@@ -430,7 +969,39 @@ func (c *Client) parseImageCaptionResponse(item json.RawMessage) interface{} {
 	return nil
 }
 
-func (c *Client) parseVideoInferenceResponse(item json.RawMessage) interface{} {
+func parseControlNetPreprocessResponse(item json.RawMessage) interface{} {
+	var resp models.ControlNetPreprocessResponse
+	if err := json.Unmarshal(item, &resp); err == nil {
+		return &resp
+	}
+	return nil
+}
+
+func parseImageMaskingResponse(item json.RawMessage) interface{} {
+	var resp models.ImageMaskingResponse
+	if err := json.Unmarshal(item, &resp); err == nil {
+		return &resp
+	}
+	return nil
+}
+
+func parsePhotoMakerResponse(item json.RawMessage) interface{} {
+	var resp models.PhotoMakerResponse
+	if err := json.Unmarshal(item, &resp); err == nil {
+		return &resp
+	}
+	return nil
+}
+
+func parseVectorizeResponse(item json.RawMessage) interface{} {
+	var resp models.VectorizeResponse
+	if err := json.Unmarshal(item, &resp); err == nil {
+		return &resp
+	}
+	return nil
+}
+
+func parseVideoInferenceResponse(item json.RawMessage) interface{} {
 	var resp models.VideoInferenceResponse
 	if err := json.Unmarshal(item, &resp); err == nil {
 		return &resp
@@ -438,7 +1009,23 @@ func (c *Client) parseVideoInferenceResponse(item json.RawMessage) interface{} {
 	return nil
 }
 
-func (c *Client) parseAudioInferenceResponse(item json.RawMessage) interface{} {
+func parseVideoUpscaleResponse(item json.RawMessage) interface{} {
+	var resp models.VideoUpscaleResponse
+	if err := json.Unmarshal(item, &resp); err == nil {
+		return &resp
+	}
+	return nil
+}
+
+func parseVideoBackgroundRemovalResponse(item json.RawMessage) interface{} {
+	var resp models.VideoBackgroundRemovalResponse
+	if err := json.Unmarshal(item, &resp); err == nil {
+		return &resp
+	}
+	return nil
+}
+
+func parseAudioInferenceResponse(item json.RawMessage) interface{} {
 	var resp models.AudioInferenceResponse
 	if err := json.Unmarshal(item, &resp); err == nil {
 		return &resp
@@ -446,23 +1033,31 @@ func (c *Client) parseAudioInferenceResponse(item json.RawMessage) interface{} {
 	return nil
 }
 
-func (c *Client) parseGetResponse(item json.RawMessage) interface{} {
-	var videoResp models.VideoInferenceResponse
-	if err := json.Unmarshal(item, &videoResp); err == nil {
-		if videoResp.Status != "" || videoResp.VideoUUID != "" || videoResp.VideoURL != nil || videoResp.ThumbnailURL != nil {
-			return &videoResp
-		}
+func parseModelSearchResponse(item json.RawMessage) interface{} {
+	var resp models.ModelSearchResponse
+	if err := json.Unmarshal(item, &resp); err == nil {
+		return &resp
 	}
-	var audioResp models.AudioInferenceResponse
-	if err := json.Unmarshal(item, &audioResp); err == nil {
-		if audioResp.Status != "" || audioResp.AudioUUID != "" || audioResp.AudioURL != nil || audioResp.AudioBase64Data != nil || audioResp.AudioDataURI != nil {
-			return &audioResp
-		}
+	return nil
+}
+
+func parseModelUploadResponse(item json.RawMessage) interface{} {
+	var resp models.ModelUploadResponse
+	if err := json.Unmarshal(item, &resp); err == nil {
+		return &resp
+	}
+	return nil
+}
+
+func parseAccountManagementResponse(item json.RawMessage) interface{} {
+	var resp models.AccountManagementResponse
+	if err := json.Unmarshal(item, &resp); err == nil {
+		return &resp
 	}
 	return nil
 }
 
-func (c *Client) pingLoop() {
+func (c *Client) pingLoop(conn *websocket.Conn, done chan struct{}) {
 	defer c.wg.Done()
 	ticker := time.NewTicker(c.config.PingInterval)
 	defer ticker.Stop()
@@ -470,30 +1065,22 @@ func (c *Client) pingLoop() {
 		select {
 		case <-c.stopChan:
 			return
+		case <-done:
+			return
 		case <-ticker.C:
-			c.mu.RLock()
-			conn := c.conn
-			c.mu.RUnlock()
-			if conn == nil {
-				return
-			}
-			c.writeMu.Lock()
-			if err := conn.SetWriteDeadline(time.Now().Add(c.config.WriteTimeout)); err != nil {
-				c.writeMu.Unlock()
-				c.triggerReconnect()
-				return
-			}
-			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
-				c.writeMu.Unlock()
-				c.triggerReconnect()
+			// WriteControl is safe alongside other writes, so pings keep flowing
+			// while a large streamed message holds writeMu
+			err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(c.config.WriteTimeout))
+			if err != nil {
+				// Closing the connection unblocks readLoop, which handles the loss
+				_ = conn.Close()
 				return
 			}
-			c.writeMu.Unlock()
 		}
 	}
 }
 
-func (c *Client) reconnectLoop(ctx context.Context) {
+func (c *Client) reconnectLoop() {
 	defer c.wg.Done()
 	delay := c.config.ReconnectDelay
 	for {
@@ -501,40 +1088,68 @@ func (c *Client) reconnectLoop(ctx context.Context) {
 		case <-c.stopChan:
 			return
 		case <-c.reconnectChan:
-			time.Sleep(delay)
-			c.mu.Lock()
-			if c.connected {
-				c.mu.Unlock()
-				continue
-			}
-			if c.conn != nil {
-				_ = c.conn.Close()
-				c.conn = nil
+		}
+
+		select {
+		case <-c.stopChan:
+			return
+		case <-time.After(delay):
+		}
+
+		resumed, err := c.reconnect()
+		if err != nil {
+			select {
+			case c.errorChan <- fmt.Errorf("reconnect failed: %w", err):
+			default:
 			}
-			c.mu.Unlock()
-			if err := c.Connect(ctx); err != nil {
-				delay *= 2
-				if delay > c.config.MaxReconnectDelay {
-					delay = c.config.MaxReconnectDelay
-				}
-				c.triggerReconnect()
-			} else {
-				delay = c.config.ReconnectDelay
+			// Don't keep requests waiting on a session that may never be resumed
+			c.failUnresumable()
+			delay *= 2
+			if delay > c.config.MaxReconnectDelay {
+				delay = c.config.MaxReconnectDelay
 			}
+			c.triggerReconnect()
+			continue
+		}
+		delay = c.config.ReconnectDelay
+		if !resumed {
+			c.failUnresumable()
 		}
+		c.resendPending()
 	}
 }
 
+// reconnect dials a replacement connection after a connection loss,
+// reporting whether the previous session was resumed
+func (c *Client) reconnect() (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.ConnectTimeout)
+	defer cancel()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.connected {
+		return true, nil
+	}
+	select {
+	case <-c.stopChan:
+		return false, ErrConnectionClosed
+	default:
+	}
+	resumed, err := c.dial(ctx)
+	if err != nil {
+		return false, err
+	}
+	c.startConnectionLoops()
+	c.debugLogger.Printf("Reconnected successfully")
+	return resumed, nil
+}
+
 func (c *Client) triggerReconnect() {
 	select {
 	case <-c.stopChan:
 		return
 	default:
 	}
-	if c.mu.TryLock() {
-		c.connected = false
-		c.mu.Unlock()
-	}
 	select {
 	case c.reconnectChan <- struct{}{}:
 	default:
@@ -544,6 +1159,7 @@ func (c *Client) triggerReconnect() {
 func (c *Client) removeHandler(taskUUID string) {
 	c.handlersMu.Lock()
 	delete(c.handlers, taskUUID)
+	delete(c.requests, taskUUID)
 	c.handlersMu.Unlock()
 }
 
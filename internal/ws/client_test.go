@@ -1,8 +1,11 @@
 package ws
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -130,13 +133,14 @@ func TestConnect(t *testing.T) {
 		}
 
 		// Verify it's an auth message
-		var authMsg map[string]interface{}
-		if err := json.Unmarshal(msg, &authMsg); err != nil {
+		var authMsg []map[string]interface{}
+		if err := json.Unmarshal(msg, &authMsg); err != nil || len(authMsg) == 0 {
+			t.Errorf("Expected auth message array, got %s", msg)
 			return
 		}
 
-		if authMsg["newConnectionToken"] == nil {
-			t.Error("Expected auth message with newConnectionToken")
+		if authMsg[0]["taskType"] != "authentication" || authMsg[0]["apiKey"] == nil {
+			t.Error("Expected authentication task with apiKey")
 		}
 
 		// Send auth success
@@ -433,6 +437,59 @@ func TestConcurrentSend(t *testing.T) {
 	}
 }
 
+func TestSendStreamedRequest(t *testing.T) {
+	receivedMessage := make(chan []byte, 1)
+	server := mockWebSocketServer(t, func(conn *websocket.Conn) {
+		conn.ReadMessage()
+		conn.WriteJSON(map[string]interface{}{
+			"connectionSessionUUID": "test-session",
+		})
+		if _, msg, err := conn.ReadMessage(); err == nil {
+			receivedMessage <- msg
+		}
+		time.Sleep(100 * time.Millisecond)
+	})
+	defer server.Close()
+
+	config := DefaultWSConfig()
+	config.URL = "ws" + strings.TrimPrefix(server.URL, "http")
+	config.EnableAutoReconnect = false
+	config.WriteBufferSize = 1024 // force the message into several frames
+
+	client := NewClient("test-api-key", config, &mockLogger{})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.Connect(ctx); err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	defer client.Disconnect()
+
+	content := bytes.Repeat([]byte("streamed content "), 1000)
+	req := &StreamedRequest{
+		Task:    models.NewUploadImageRequest(),
+		Field:   "imageDataURI",
+		Prefix:  "data:image/png;base64,",
+		Content: bytes.NewReader(content),
+	}
+	if err := client.Send(ctx, req, func(interface{}, error) {}); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	select {
+	case msg := <-receivedMessage:
+		var tasks []models.UploadImageRequest
+		if err := json.Unmarshal(msg, &tasks); err != nil || len(tasks) != 1 {
+			t.Fatalf("message is not a task array: %v", err)
+		}
+		want := "data:image/png;base64," + base64.StdEncoding.EncodeToString(content)
+		if tasks[0].TaskUUID != req.GetTaskUUID() || tasks[0].ImageDataURI == nil || *tasks[0].ImageDataURI != want {
+			t.Errorf("streamed task = %+v, want the encoded content under imageDataURI", tasks[0])
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Did not receive the streamed message")
+	}
+}
+
 func TestSendWithoutConnection(t *testing.T) {
 	client := NewClient("test-key", DefaultWSConfig(), &mockLogger{})
 
@@ -447,3 +504,618 @@ func TestSendWithoutConnection(t *testing.T) {
 		t.Error("Send() should fail when not connected")
 	}
 }
+
+func TestHandleMessageErrorEnvelopes(t *testing.T) {
+	tests := []struct {
+		name     string
+		message  string
+		wantCode string
+	}{
+		{
+			name:     "errors array",
+			message:  `{"errors":[{"code":"invalidPositivePrompt","message":"bad prompt","parameter":"positivePrompt","taskType":"imageInference","taskUUID":"task-1"}]}`,
+			wantCode: "invalidPositivePrompt",
+		},
+		{
+			name:     "nested error object",
+			message:  `{"error":{"code":"rateLimitExceeded","message":"slow down","taskUUID":"task-1"}}`,
+			wantCode: "rateLimitExceeded",
+		},
+		{
+			name:     "flat error object",
+			message:  `{"error":"something failed","errorId":"serviceUnavailable","taskUUID":"task-1"}`,
+			wantCode: "serviceUnavailable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient("test-key", DefaultWSConfig(), &mockLogger{})
+
+			var got error
+			client.handlers["task-1"] = func(data interface{}, err error) { got = err }
+
+			client.handleMessage([]byte(tt.message))
+
+			var respErr *ResponseError
+			if !errors.As(got, &respErr) {
+				t.Fatalf("handler error = %v, want *ResponseError", got)
+			}
+			code := respErr.Response.ErrorID
+			if code == "" {
+				code = respErr.Response.Code
+			}
+			if code != tt.wantCode {
+				t.Errorf("error code = %q, want %q", code, tt.wantCode)
+			}
+			if len(respErr.Raw) == 0 {
+				t.Error("Raw payload not captured")
+			}
+			if _, ok := client.handlers["task-1"]; ok {
+				t.Error("handler not removed after error")
+			}
+		})
+	}
+}
+
+func TestParseGetResponse(t *testing.T) {
+	items := []json.RawMessage{
+		json.RawMessage(`{"taskType":"imageInference","taskUUID":"task-1","imageUUID":"img-1"}`),
+		json.RawMessage(`{"taskType":"imageInference","taskUUID":"task-1","imageUUID":"img-2"}`),
+		json.RawMessage(`{"taskType":"somethingNew","taskUUID":"task-1"}`),
+	}
+
+	result := ParseGetResponse("task-1", items)
+
+	if result.TaskType != models.TaskTypeImageInference || result.TaskUUID != "task-1" {
+		t.Errorf("result = %s/%s, want imageInference/task-1", result.TaskType, result.TaskUUID)
+	}
+	if len(result.Images) != 2 || result.Images[1].ImageUUID != "img-2" {
+		t.Errorf("Images = %v, want both image results", result.Images)
+	}
+	if len(result.Videos) != 0 || len(result.Audio) != 0 {
+		t.Error("image results misclassified as video or audio")
+	}
+	if len(result.Unknown) != 1 {
+		t.Errorf("Unknown = %d items, want 1", len(result.Unknown))
+	}
+}
+
+func TestHandleMessageGroupsGetResponseItems(t *testing.T) {
+	client := NewClient("test-key", DefaultWSConfig(), &mockLogger{})
+
+	var got []interface{}
+	client.handlers["task-1"] = func(data interface{}, err error) { got = append(got, data) }
+	client.requests["task-1"] = models.NewGetResponseRequest("task-1")
+
+	client.handleMessage([]byte(`{"data":[` +
+		`{"taskType":"imageUpscale","taskUUID":"task-1","imageUUID":"up-1"},` +
+		`{"taskType":"imageBackgroundRemoval","taskUUID":"task-1","imageUUID":"bg-1"}]}`))
+
+	if len(got) != 1 {
+		t.Fatalf("handler called %d times, want once with every item", len(got))
+	}
+	result, ok := got[0].(*models.GetResponseResult)
+	if !ok {
+		t.Fatalf("handler data = %T, want *models.GetResponseResult", got[0])
+	}
+	if len(result.Upscales) != 1 || len(result.BackgroundRemovals) != 1 {
+		t.Errorf("result = %+v, want one upscale and one background removal", result)
+	}
+}
+
+func TestHandleMessageConnectionLevelError(t *testing.T) {
+	client := NewClient("test-key", DefaultWSConfig(), &mockLogger{})
+
+	got := make(chan error, 1)
+	client.SetErrorHandler(func(err error) { got <- err })
+
+	client.handleMessage([]byte(`{"errors":[{"code":"invalidApiKey","message":"Invalid API key","taskType":"authentication"}]}`))
+
+	select {
+	case err := <-got:
+		var respErr *ResponseError
+		if !errors.As(err, &respErr) || respErr.Response.Code != "invalidApiKey" {
+			t.Errorf("connection error = %v, want invalidApiKey ResponseError", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("error handler was not called")
+	}
+}
+
+func TestSlowErrorHandlerDoesNotBlockDispatch(t *testing.T) {
+	client := NewClient("test-key", DefaultWSConfig(), &mockLogger{})
+
+	unblock := make(chan struct{})
+	defer close(unblock)
+	client.SetErrorHandler(func(error) { <-unblock })
+
+	done := make(chan struct{})
+	go func() {
+		client.handleMessage([]byte(`{"errors":[{"code":"invalidRequest","message":"Missing taskUUID"}]}`))
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("handleMessage blocked on the error handler")
+	}
+}
+
+func TestDisconnectFailsPendingHandlers(t *testing.T) {
+	server := mockWebSocketServer(t, func(conn *websocket.Conn) {
+		conn.ReadMessage()
+		conn.WriteJSON(map[string]interface{}{
+			"connectionSessionUUID": "test-session",
+		})
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				break
+			}
+		}
+	})
+	defer server.Close()
+
+	config := DefaultWSConfig()
+	config.URL = "ws" + strings.TrimPrefix(server.URL, "http")
+	config.EnableAutoReconnect = false
+
+	client := NewClient("test-api-key", config, &mockLogger{})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.Connect(ctx); err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+
+	errCh := make(chan error, 1)
+	req := models.NewImageInferenceRequest("test", "model", 512, 512)
+	if err := client.Send(ctx, req, func(data interface{}, err error) { errCh <- err }); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	client.Disconnect()
+
+	select {
+	case err := <-errCh:
+		if !errors.Is(err, ErrConnectionClosed) {
+			t.Errorf("handler error = %v, want ErrConnectionClosed", err)
+		}
+		if !strings.Contains(err.Error(), req.TaskUUID) {
+			t.Errorf("handler error %q missing TaskUUID", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("pending handler was not failed on Disconnect")
+	}
+}
+
+func TestDisconnectWaitsForDeliveries(t *testing.T) {
+	server := mockWebSocketServer(t, func(conn *websocket.Conn) {
+		conn.ReadMessage()
+		conn.WriteJSON(map[string]interface{}{
+			"connectionSessionUUID": "test-session",
+		})
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var tasks []map[string]interface{}
+		_ = json.Unmarshal(message, &tasks)
+		// Keep results flowing until the client goes away
+		result := map[string]interface{}{"data": []map[string]interface{}{{
+			"taskType": "imageInference", "taskUUID": tasks[0]["taskUUID"], "imageUUID": "img-1",
+		}}}
+		for conn.WriteJSON(result) == nil {
+		}
+	})
+	defer server.Close()
+
+	config := DefaultWSConfig()
+	config.URL = "ws" + strings.TrimPrefix(server.URL, "http")
+	config.EnableAutoReconnect = false
+
+	client := NewClient("test-api-key", config, &mockLogger{})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.Connect(ctx); err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+
+	var mu sync.Mutex
+	disconnected := false
+	delivered := make(chan struct{}, 1)
+	var late int
+	req := models.NewImageInferenceRequest("test", "model", 512, 512)
+	err := client.Send(ctx, req, func(data interface{}, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if disconnected {
+			late++
+		}
+		select {
+		case delivered <- struct{}{}:
+		default:
+		}
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	<-delivered
+
+	client.Disconnect()
+	mu.Lock()
+	disconnected = true
+	mu.Unlock()
+
+	// A following Connect must not race with the previous session's goroutines
+	if err := client.Connect(ctx); err != nil {
+		t.Fatalf("Connect() after Disconnect() error = %v", err)
+	}
+	client.Disconnect()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if late > 0 {
+		t.Errorf("handler called %d times after Disconnect() returned", late)
+	}
+}
+
+func TestConnectionLossFailsPendingHandlers(t *testing.T) {
+	server := mockWebSocketServer(t, func(conn *websocket.Conn) {
+		conn.ReadMessage()
+		conn.WriteJSON(map[string]interface{}{
+			"connectionSessionUUID": "test-session",
+		})
+		// Drop the connection as soon as a task arrives
+		conn.ReadMessage()
+	})
+	defer server.Close()
+
+	config := DefaultWSConfig()
+	config.URL = "ws" + strings.TrimPrefix(server.URL, "http")
+	config.EnableAutoReconnect = false
+
+	client := NewClient("test-api-key", config, &mockLogger{})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.Connect(ctx); err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	defer client.Disconnect()
+
+	errCh := make(chan error, 1)
+	req := models.NewImageInferenceRequest("test", "model", 512, 512)
+	if err := client.Send(ctx, req, func(data interface{}, err error) { errCh <- err }); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	select {
+	case err := <-errCh:
+		if !errors.Is(err, ErrConnectionClosed) {
+			t.Errorf("handler error = %v, want ErrConnectionClosed", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("pending handler was not failed on connection loss")
+	}
+
+	if client.IsConnected() {
+		t.Error("client still reports connected after connection loss")
+	}
+}
+
+func TestConnectionLossFailsPendingHandlersImmediatelyByDefault(t *testing.T) {
+	server := mockWebSocketServer(t, func(conn *websocket.Conn) {
+		conn.ReadMessage()
+		conn.WriteJSON(map[string]interface{}{
+			"connectionSessionUUID": "test-session",
+		})
+		// Drop the connection as soon as a task arrives
+		conn.ReadMessage()
+	})
+	defer server.Close()
+
+	// Auto-reconnect stays on, so a slow reconnect would show up as a late failure
+	config := DefaultWSConfig()
+	config.URL = "ws" + strings.TrimPrefix(server.URL, "http")
+	config.ReconnectDelay = 5 * time.Second
+
+	client := NewClient("test-api-key", config, &mockLogger{})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := client.Connect(ctx); err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	defer client.Disconnect()
+
+	errCh := make(chan error, 1)
+	req := models.NewImageInferenceRequest("test", "model", 512, 512)
+	start := time.Now()
+	if err := client.Send(ctx, req, func(data interface{}, err error) { errCh <- err }); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	select {
+	case err := <-errCh:
+		if !errors.Is(err, ErrConnectionClosed) {
+			t.Errorf("handler error = %v, want ErrConnectionClosed", err)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("handler failed after %v, want well under ReconnectDelay", elapsed)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("pending handler was not failed as soon as the connection dropped")
+	}
+}
+
+func TestConnectTasklessErrorIsNotInvalidAPIKey(t *testing.T) {
+	server := mockWebSocketServer(t, func(conn *websocket.Conn) {
+		conn.ReadMessage()
+		conn.WriteJSON(map[string]interface{}{
+			"errors": []map[string]interface{}{{
+				"code":    "rateLimitExceeded",
+				"message": "Too many requests",
+			}},
+		})
+		time.Sleep(100 * time.Millisecond)
+	})
+	defer server.Close()
+
+	config := DefaultWSConfig()
+	config.URL = "ws" + strings.TrimPrefix(server.URL, "http")
+	config.EnableAutoReconnect = false
+
+	client := NewClient("test-api-key", config, &mockLogger{})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := client.Connect(ctx)
+	if err == nil {
+		t.Fatal("Connect() should fail when the handshake returns an error")
+	}
+	if errors.Is(err, ErrInvalidAPIKey) {
+		t.Errorf("Connect() error = %v, should not be ErrInvalidAPIKey", err)
+	}
+	var respErr *ResponseError
+	if !errors.As(err, &respErr) || respErr.Response.Code != "rateLimitExceeded" {
+		t.Errorf("Connect() error = %v, want wrapped rateLimitExceeded ResponseError", err)
+	}
+}
+
+func TestResendIdempotentTasksAfterReconnect(t *testing.T) {
+	var mu sync.Mutex
+	connections := 0
+
+	server := mockWebSocketServer(t, func(conn *websocket.Conn) {
+		mu.Lock()
+		connections++
+		n := connections
+		mu.Unlock()
+
+		conn.ReadMessage()
+		conn.WriteJSON(map[string]interface{}{
+			"connectionSessionUUID": "test-session",
+		})
+
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		if n == 1 {
+			// Drop the first connection before answering
+			return
+		}
+
+		var reqs []map[string]interface{}
+		if err := json.Unmarshal(msg, &reqs); err != nil || len(reqs) == 0 {
+			return
+		}
+		conn.WriteJSON(map[string]interface{}{
+			"data": []map[string]interface{}{{
+				"taskType":  models.TaskTypeVideoInference,
+				"taskUUID":  reqs[0]["taskUUID"],
+				"status":    "success",
+				"videoUUID": "video-1",
+			}},
+		})
+		time.Sleep(200 * time.Millisecond)
+	})
+	defer server.Close()
+
+	config := DefaultWSConfig()
+	config.URL = "ws" + strings.TrimPrefix(server.URL, "http")
+	config.ReconnectDelay = 10 * time.Millisecond
+	config.ResumeSession = false
+	config.ResendOnReconnect = true
+
+	client := NewClient("test-api-key", config, &mockLogger{})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.Connect(ctx); err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	defer client.Disconnect()
+
+	type result struct {
+		data interface{}
+		err  error
+	}
+	resCh := make(chan result, 1)
+	req := models.NewGetResponseRequest("task-to-resume")
+	if err := client.Send(ctx, req, func(data interface{}, err error) { resCh <- result{data, err} }); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	select {
+	case res := <-resCh:
+		if res.err != nil {
+			t.Fatalf("handler error = %v, want response after resend", res.err)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("idempotent task was not re-sent after reconnect")
+	}
+}
+
+func TestConnectInvalidAPIKey(t *testing.T) {
+	server := mockWebSocketServer(t, func(conn *websocket.Conn) {
+		conn.ReadMessage()
+		conn.WriteJSON(map[string]interface{}{
+			"errors": []map[string]interface{}{{
+				"code":     "invalidApiKey",
+				"message":  "Invalid API key",
+				"taskType": "authentication",
+			}},
+		})
+		time.Sleep(100 * time.Millisecond)
+	})
+	defer server.Close()
+
+	config := DefaultWSConfig()
+	config.URL = "ws" + strings.TrimPrefix(server.URL, "http")
+	config.EnableAutoReconnect = false
+
+	client := NewClient("bad-key", config, &mockLogger{})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := client.Connect(ctx)
+	if !errors.Is(err, ErrInvalidAPIKey) {
+		t.Fatalf("Connect() error = %v, want ErrInvalidAPIKey", err)
+	}
+	var respErr *ResponseError
+	if !errors.As(err, &respErr) || respErr.Response.Code != "invalidApiKey" {
+		t.Errorf("Connect() error = %v, want wrapped ResponseError", err)
+	}
+	if client.IsConnected() {
+		t.Error("client connected despite rejected authentication")
+	}
+}
+
+func TestConnectStoresSessionUUID(t *testing.T) {
+	server := mockWebSocketServer(t, func(conn *websocket.Conn) {
+		conn.ReadMessage()
+		conn.WriteJSON(map[string]interface{}{
+			"data": []map[string]interface{}{{
+				"taskType":              "authentication",
+				"connectionSessionUUID": "session-123",
+			}},
+		})
+		time.Sleep(100 * time.Millisecond)
+	})
+	defer server.Close()
+
+	config := DefaultWSConfig()
+	config.URL = "ws" + strings.TrimPrefix(server.URL, "http")
+	config.EnableAutoReconnect = false
+
+	client := NewClient("test-api-key", config, &mockLogger{})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.Connect(ctx); err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	defer client.Disconnect()
+
+	if got := client.SessionUUID(); got != "session-123" {
+		t.Errorf("SessionUUID() = %q, want session-123", got)
+	}
+}
+
+func TestSessionResumptionDeliversPendingResults(t *testing.T) {
+	var mu sync.Mutex
+	connections := 0
+	var taskUUID string
+	resumeToken := make(chan interface{}, 1)
+
+	server := mockWebSocketServer(t, func(conn *websocket.Conn) {
+		mu.Lock()
+		connections++
+		n := connections
+		mu.Unlock()
+
+		_, authMsg, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		conn.WriteJSON(map[string]interface{}{
+			"connectionSessionUUID": "session-1",
+		})
+
+		if n == 1 {
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			var reqs []map[string]interface{}
+			if json.Unmarshal(msg, &reqs) == nil && len(reqs) > 0 {
+				mu.Lock()
+				taskUUID, _ = reqs[0]["taskUUID"].(string)
+				mu.Unlock()
+			}
+			// Drop the connection before the result is ready
+			return
+		}
+
+		var auth []map[string]interface{}
+		if json.Unmarshal(authMsg, &auth) == nil && len(auth) > 0 {
+			resumeToken <- auth[0]["connectionSessionUUID"]
+		}
+
+		// Deliver the result on the resumed session without a re-send
+		mu.Lock()
+		uuid := taskUUID
+		mu.Unlock()
+		conn.WriteJSON(map[string]interface{}{
+			"data": []map[string]interface{}{{
+				"taskType":  models.TaskTypeImageInference,
+				"taskUUID":  uuid,
+				"imageUUID": "image-1",
+			}},
+		})
+		time.Sleep(200 * time.Millisecond)
+	})
+	defer server.Close()
+
+	config := DefaultWSConfig()
+	config.URL = "ws" + strings.TrimPrefix(server.URL, "http")
+	config.ReconnectDelay = 10 * time.Millisecond
+	config.ResumeSession = true
+
+	client := NewClient("test-api-key", config, &mockLogger{})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.Connect(ctx); err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	defer client.Disconnect()
+
+	type result struct {
+		data interface{}
+		err  error
+	}
+	resCh := make(chan result, 1)
+	req := models.NewImageInferenceRequest("test", "model", 512, 512)
+	if err := client.Send(ctx, req, func(data interface{}, err error) { resCh <- result{data, err} }); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	select {
+	case token := <-resumeToken:
+		if token != "session-1" {
+			t.Errorf("reconnect presented connectionSessionUUID %v, want session-1", token)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("client did not reconnect")
+	}
+
+	select {
+	case res := <-resCh:
+		if res.err != nil {
+			t.Fatalf("handler error = %v, want resumed result", res.err)
+		}
+		if resp, ok := res.data.(*models.ImageInferenceResponse); !ok || resp.ImageUUID != "image-1" {
+			t.Errorf("handler data = %#v, want image-1 response", res.data)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("result for resumed task was not delivered")
+	}
+}
@@ -0,0 +1,77 @@
+package ws
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	models "github.com/Ryank90/runware-go-sdk/models"
+)
+
+// StreamedRequest is a task whose content is base64-encoded straight into the
+// outgoing message as it is written, so large uploads are never marshalled in memory.
+//
+// Sending consumes Content, so a StreamedRequest can only be sent once.
+type StreamedRequest struct {
+	// Task is the request without its content field. It must marshal to a JSON
+	// object and implement models.TaskIdentifiable.
+	Task interface{}
+	// Field is the JSON name of the content field (e.g. "imageDataURI")
+	Field string
+	// Prefix is written before the encoded content (e.g. "data:image/png;base64,")
+	Prefix string
+	// Content is the raw content to encode
+	Content io.Reader
+}
+
+// GetTaskUUID implements models.TaskIdentifiable
+func (r *StreamedRequest) GetTaskUUID() string {
+	if ti, ok := r.Task.(models.TaskIdentifiable); ok {
+		return ti.GetTaskUUID()
+	}
+	return ""
+}
+
+// GetTaskType implements models.TaskIdentifiable
+func (r *StreamedRequest) GetTaskType() string {
+	if ti, ok := r.Task.(models.TaskIdentifiable); ok {
+		return ti.GetTaskType()
+	}
+	return ""
+}
+
+// WriteMessage writes the request as a single-task JSON array. If Content fails
+// part way, the message written so far is not valid JSON, so the API rejects it.
+func (r *StreamedRequest) WriteMessage(w io.Writer) error {
+	head, err := json.Marshal(r.Task)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+	if len(head) < 2 || head[0] != '{' || head[len(head)-1] != '}' {
+		return fmt.Errorf("streamed request must marshal to a JSON object")
+	}
+	field, _ := json.Marshal(r.Field)
+	prefix, _ := json.Marshal(r.Prefix)
+
+	// [{...existing fields,"field":"prefix<base64>"}]
+	head = head[:len(head)-1]
+	if len(head) > 1 {
+		head = append(head, ',')
+	}
+	open := append(append(append([]byte{'['}, head...), field...), ':')
+	open = append(open, prefix[:len(prefix)-1]...)
+	if _, err := w.Write(open); err != nil {
+		return err
+	}
+
+	enc := base64.NewEncoder(base64.StdEncoding, w)
+	if _, err := io.Copy(enc, r.Content); err != nil {
+		return fmt.Errorf("failed to read upload: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, `"}]`)
+	return err
+}
@@ -0,0 +1,288 @@
+package runware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	models "github.com/Ryank90/runware-go-sdk/models"
+)
+
+// JobBackoff configures how a Job polls for the result of an async task.
+type JobBackoff struct {
+	// InitialInterval is the delay before the second poll.
+	InitialInterval time.Duration
+
+	// MaxInterval caps the delay between polls.
+	MaxInterval time.Duration
+
+	// Multiplier scales the interval after each poll. Values below 1 are treated as 1,
+	// which polls at a fixed InitialInterval.
+	Multiplier float64
+
+	// PollTimeout bounds each individual getResponse request. Polls that time out
+	// are retried; the overall wait is bounded only by the context passed to Wait.
+	PollTimeout time.Duration
+
+	// MaxPolls stops waiting after this many polls. Zero means no limit.
+	MaxPolls int
+}
+
+// DefaultJobBackoff returns a backoff that polls after 2 seconds, growing by 1.5x
+// up to 15 seconds between polls, with a 30-second timeout per poll.
+func DefaultJobBackoff() *JobBackoff {
+	return &JobBackoff{
+		InitialInterval: 2 * time.Second,
+		MaxInterval:     15 * time.Second,
+		Multiplier:      1.5,
+		PollTimeout:     30 * time.Second,
+	}
+}
+
+// interval returns the delay after the given poll (1-based)
+func (b *JobBackoff) interval(poll int) time.Duration {
+	multiplier := math.Max(b.Multiplier, 1)
+	delay := float64(b.InitialInterval) * math.Pow(multiplier, float64(poll-1))
+	if b.MaxInterval > 0 && delay > float64(b.MaxInterval) {
+		delay = float64(b.MaxInterval)
+	}
+	return time.Duration(delay)
+}
+
+// JobError is returned when an async task fails on the server.
+//
+// APIError carries the failure detail reported by the API, if any, and is
+// returned by Unwrap so errors.As(err, &apiErr) works on a JobError.
+type JobError struct {
+	// TaskUUID is the unique identifier of the failed task
+	TaskUUID string
+	// TaskType is the type of the failed task (e.g. "videoInference")
+	TaskType string
+	// Status is the last status reported for the task
+	Status models.TaskStatus
+	// APIError is the error reported by the API, or nil if it only reported an error status
+	APIError *APIError
+}
+
+// Error implements the error interface
+func (e *JobError) Error() string {
+	if e.APIError != nil {
+		return fmt.Sprintf("%s task %s failed: %v", e.TaskType, e.TaskUUID, e.APIError)
+	}
+	return fmt.Sprintf("%s task %s failed with status %q", e.TaskType, e.TaskUUID, e.Status)
+}
+
+// Unwrap returns the API error, if any
+func (e *JobError) Unwrap() error {
+	if e.APIError == nil {
+		return nil
+	}
+	return e.APIError
+}
+
+// Job is a handle to an async task, such as video or audio generation.
+//
+// Example:
+//
+//	job, err := client.VideoInferenceAsync(ctx, req)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	video, err := job.Wait(ctx)
+//	var jobErr *runware.JobError
+//	if errors.As(err, &jobErr) {
+//	    log.Printf("generation failed: %v", jobErr.APIError)
+//	}
+type Job[T models.AsyncResult] struct {
+	client   *Client
+	taskUUID string
+	taskType string
+	backoff  *JobBackoff
+}
+
+// newJob creates a handle for an async task
+func newJob[T models.AsyncResult](c *Client, taskType, taskUUID string) *Job[T] {
+	return &Job[T]{
+		client:   c,
+		taskUUID: taskUUID,
+		taskType: taskType,
+		backoff:  DefaultJobBackoff(),
+	}
+}
+
+// TaskUUID returns the unique identifier of the task
+func (j *Job[T]) TaskUUID() string { return j.taskUUID }
+
+// WithBackoff sets how Wait polls for the result. A nil backoff restores the default.
+func (j *Job[T]) WithBackoff(backoff *JobBackoff) *Job[T] {
+	if backoff == nil {
+		backoff = DefaultJobBackoff()
+	}
+	j.backoff = backoff
+	return j
+}
+
+// Status polls the task once and returns its latest response.
+// The response's Status field reports whether the task is still processing.
+//
+// Returns a *JobError if the task has failed, and ErrInvalidResponse if the API
+// returned a response of an unexpected type.
+func (j *Job[T]) Status(ctx context.Context) (T, error) {
+	var zero T
+
+	resp, err := j.client.GetResponse(ctx, j.taskUUID)
+	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && !apiErr.IsRetryable() {
+			return zero, &JobError{
+				TaskUUID: j.taskUUID,
+				TaskType: j.taskType,
+				Status:   models.TaskStatusError,
+				APIError: apiErr,
+			}
+		}
+		return zero, err
+	}
+
+	var result T
+	found := false
+	for _, item := range resp.All() {
+		if typed, ok := item.(T); ok {
+			result, found = typed, true
+			break
+		}
+	}
+	if !found {
+		return zero, fmt.Errorf("%w: no %T result polling %s task %s (got %q)",
+			ErrInvalidResponse, zero, j.taskType, j.taskUUID, resp.TaskType)
+	}
+	if result.GetStatus() == models.TaskStatusError {
+		return result, &JobError{TaskUUID: j.taskUUID, TaskType: j.taskType, Status: models.TaskStatusError}
+	}
+	return result, nil
+}
+
+// Wait polls the task until it succeeds, fails or ctx is done.
+//
+// Polls that time out or fail with a retryable API error are retried after the
+// next backoff interval. Returns a *JobError if the task fails on the server.
+func (j *Job[T]) Wait(ctx context.Context) (T, error) {
+	var zero T
+	backoff := j.backoff
+
+	j.client.debugLogger.Printf("Waiting for %s task %s", j.taskType, j.taskUUID)
+
+	for poll := 1; backoff.MaxPolls <= 0 || poll <= backoff.MaxPolls; poll++ {
+		pollCtx, cancel := ctx, context.CancelFunc(func() {})
+		if backoff.PollTimeout > 0 {
+			pollCtx, cancel = context.WithTimeout(ctx, backoff.PollTimeout)
+		}
+		result, err := j.Status(pollCtx)
+		cancel()
+
+		switch {
+		case ctx.Err() != nil:
+			return zero, ctx.Err()
+		case err == nil && result.GetStatus() == models.TaskStatusSuccess:
+			j.client.debugLogger.Printf("%s task %s completed", j.taskType, j.taskUUID)
+			return result, nil
+		case err == nil:
+			j.client.debugLogger.Printf("Poll %d for %s task %s: status %q", poll, j.taskType, j.taskUUID, result.GetStatus())
+		case j.isTransient(err):
+			j.client.debugLogger.Printf("Poll %d for %s task %s failed: %v - retrying", poll, j.taskType, j.taskUUID, err)
+		default:
+			return zero, err
+		}
+
+		timer := time.NewTimer(backoff.interval(poll))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return zero, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	return zero, fmt.Errorf("%w: %s task %s still pending after %d polls", ErrTimeout, j.taskType, j.taskUUID, backoff.MaxPolls)
+}
+
+// isTransient reports whether a failed poll should be retried
+func (j *Job[T]) isTransient(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.IsRetryable()
+	}
+	return IsTimeout(err) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// VideoInferenceAsync submits a video inference request and returns a Job that
+// can be waited on for the finished video.
+func (c *Client) VideoInferenceAsync(ctx context.Context, req *models.VideoInferenceRequest) (*Job[*models.VideoInferenceResponse], error) {
+	ack, err := c.VideoInference(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return c.VideoJob(ack.TaskUUID), nil
+}
+
+// AudioInferenceAsync submits an audio inference request and returns a Job that
+// can be waited on for the finished audio.
+func (c *Client) AudioInferenceAsync(ctx context.Context, req *models.AudioInferenceRequest) (*Job[*models.AudioInferenceResponse], error) {
+	ack, err := c.AudioInference(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return c.AudioJob(ack.TaskUUID), nil
+}
+
+// VideoJob returns a Job for a previously submitted video inference task
+func (c *Client) VideoJob(taskUUID string) *Job[*models.VideoInferenceResponse] {
+	return newJob[*models.VideoInferenceResponse](c, models.TaskTypeVideoInference, taskUUID)
+}
+
+// AudioJob returns a Job for a previously submitted audio inference task
+func (c *Client) AudioJob(taskUUID string) *Job[*models.AudioInferenceResponse] {
+	return newJob[*models.AudioInferenceResponse](c, models.TaskTypeAudioInference, taskUUID)
+}
+
+// VideoUpscaleAsync submits a video upscale request and returns a Job that
+// can be waited on for the upscaled video.
+func (c *Client) VideoUpscaleAsync(ctx context.Context, req *models.VideoUpscaleRequest) (*Job[*models.VideoUpscaleResponse], error) {
+	ack, err := c.VideoUpscale(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return c.VideoUpscaleJob(ack.TaskUUID), nil
+}
+
+// VideoBackgroundRemovalAsync submits a video background removal request and
+// returns a Job that can be waited on for the processed video.
+func (c *Client) VideoBackgroundRemovalAsync(ctx context.Context, req *models.VideoBackgroundRemovalRequest) (*Job[*models.VideoBackgroundRemovalResponse], error) {
+	ack, err := c.VideoBackgroundRemoval(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return c.VideoBackgroundRemovalJob(ack.TaskUUID), nil
+}
+
+// VideoUpscaleJob returns a Job for a previously submitted video upscale task
+func (c *Client) VideoUpscaleJob(taskUUID string) *Job[*models.VideoUpscaleResponse] {
+	return newJob[*models.VideoUpscaleResponse](c, models.TaskTypeVideoUpscale, taskUUID)
+}
+
+// VideoBackgroundRemovalJob returns a Job for a previously submitted video background removal task
+func (c *Client) VideoBackgroundRemovalJob(taskUUID string) *Job[*models.VideoBackgroundRemovalResponse] {
+	return newJob[*models.VideoBackgroundRemovalResponse](c, models.TaskTypeVideoBackgroundRemoval, taskUUID)
+}
+
+// fixedIntervalBackoff polls every interval, at most maxPolls times
+func fixedIntervalBackoff(maxPolls int, interval time.Duration) *JobBackoff {
+	return &JobBackoff{
+		InitialInterval: interval,
+		Multiplier:      1,
+		PollTimeout:     30 * time.Second,
+		MaxPolls:        maxPolls,
+	}
+}
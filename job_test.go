@@ -0,0 +1,217 @@
+package runware
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Ryank90/runware-go-sdk/models"
+)
+
+func fastJobBackoff() *JobBackoff {
+	return &JobBackoff{InitialInterval: time.Millisecond, Multiplier: 1, PollTimeout: time.Second}
+}
+
+func videoStatusResponse(task map[string]interface{}, status string) interface{} {
+	item := map[string]interface{}{
+		"taskType": models.TaskTypeVideoInference,
+		"taskUUID": task["taskUUID"],
+		"status":   status,
+	}
+	if status == string(models.TaskStatusSuccess) {
+		item["videoURL"] = "https://example.com/video.mp4"
+	}
+	return map[string]interface{}{"data": []interface{}{item}}
+}
+
+func TestJobBackoffInterval(t *testing.T) {
+	b := &JobBackoff{InitialInterval: time.Second, MaxInterval: 5 * time.Second, Multiplier: 2}
+
+	tests := []struct {
+		poll int
+		want time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{4, 5 * time.Second},
+	}
+	for _, tt := range tests {
+		if got := b.interval(tt.poll); got != tt.want {
+			t.Errorf("interval(%d) = %v, want %v", tt.poll, got, tt.want)
+		}
+	}
+}
+
+func TestJobWaitPollsUntilSuccess(t *testing.T) {
+	var polls atomic.Int32
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		if task["taskType"] != models.TaskTypeGetResponse {
+			t.Errorf("taskType = %v, want getResponse", task["taskType"])
+		}
+		if polls.Add(1) < 3 {
+			return videoStatusResponse(task, string(models.TaskStatusProcessing))
+		}
+		return videoStatusResponse(task, string(models.TaskStatusSuccess))
+	})
+
+	video, err := client.VideoJob(testUUID).WithBackoff(fastJobBackoff()).Wait(context.Background())
+	if err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+	if video.VideoURL == nil {
+		t.Error("VideoURL should be set")
+	}
+	if polls.Load() != 3 {
+		t.Errorf("polled %d times, want 3", polls.Load())
+	}
+}
+
+func TestJobWaitReturnsJobErrorWithDetail(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		return map[string]interface{}{"errors": []map[string]interface{}{{
+			"code":     "providerError",
+			"message":  "Content moderation rejected the prompt",
+			"taskUUID": task["taskUUID"],
+		}}}
+	})
+
+	_, err := client.VideoJob(testUUID).WithBackoff(fastJobBackoff()).Wait(context.Background())
+
+	var jobErr *JobError
+	if !errors.As(err, &jobErr) {
+		t.Fatalf("error = %v, want *JobError", err)
+	}
+	if jobErr.TaskUUID != testUUID || jobErr.TaskType != models.TaskTypeVideoInference {
+		t.Errorf("JobError = %+v, want task %s/%s", jobErr, models.TaskTypeVideoInference, testUUID)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Message != "Content moderation rejected the prompt" {
+		t.Errorf("JobError should carry the API failure detail, got %v", jobErr.APIError)
+	}
+}
+
+func TestJobStatusErrorStatus(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		return videoStatusResponse(task, string(models.TaskStatusError))
+	})
+
+	resp, err := client.VideoJob(testUUID).Status(context.Background())
+	var jobErr *JobError
+	if !errors.As(err, &jobErr) {
+		t.Fatalf("error = %v, want *JobError", err)
+	}
+	if jobErr.Status != models.TaskStatusError || resp == nil {
+		t.Errorf("Status() = %v, %+v; want the error response and status", resp, jobErr)
+	}
+}
+
+func TestJobStatusUnexpectedType(t *testing.T) {
+	var polls atomic.Int32
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		polls.Add(1)
+		return map[string]interface{}{"data": []interface{}{map[string]interface{}{
+			"taskType": models.TaskTypeAudioInference,
+			"taskUUID": task["taskUUID"],
+			"status":   "processing",
+		}}}
+	})
+
+	_, err := client.VideoJob(testUUID).WithBackoff(fastJobBackoff()).Wait(context.Background())
+	if !errors.Is(err, ErrInvalidResponse) {
+		t.Errorf("error = %v, want ErrInvalidResponse", err)
+	}
+	if polls.Load() != 1 {
+		t.Errorf("polled %d times, want 1", polls.Load())
+	}
+}
+
+func TestJobWaitRespectsContext(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		return videoStatusResponse(task, string(models.TaskStatusProcessing))
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	backoff := &JobBackoff{InitialInterval: time.Hour, PollTimeout: time.Second}
+	start := time.Now()
+	_, err := client.VideoJob(testUUID).WithBackoff(backoff).Wait(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("error = %v, want context.DeadlineExceeded", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Error("Wait() should return promptly when the context is done")
+	}
+}
+
+func TestJobWaitMaxPolls(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		return videoStatusResponse(task, string(models.TaskStatusProcessing))
+	})
+
+	_, err := client.PollVideoResult(context.Background(), testUUID, 2, time.Millisecond)
+	if !IsTimeout(err) {
+		t.Errorf("error = %v, want timeout after max polls", err)
+	}
+}
+
+func TestVideoUpscaleAsync(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		switch task["taskType"] {
+		case models.TaskTypeVideoUpscale:
+			if task["inputVideo"] != "https://example.com/clip.mp4" || task["upscaleFactor"] != float64(2) {
+				t.Errorf("unexpected request: %v", task)
+			}
+			if task["deliveryMethod"] != string(models.DeliveryMethodAsync) {
+				t.Errorf("deliveryMethod = %v, want async", task["deliveryMethod"])
+			}
+			return map[string]interface{}{"data": []interface{}{map[string]interface{}{
+				"taskType": models.TaskTypeVideoUpscale,
+				"taskUUID": task["taskUUID"],
+			}}}
+		case models.TaskTypeGetResponse:
+			return map[string]interface{}{"data": []interface{}{map[string]interface{}{
+				"taskType": models.TaskTypeVideoUpscale,
+				"taskUUID": task["taskUUID"],
+				"status":   models.TaskStatusSuccess,
+				"videoURL": "https://example.com/upscaled.mp4",
+			}}}
+		}
+		t.Errorf("unexpected taskType %v", task["taskType"])
+		return nil
+	})
+
+	job, err := client.VideoUpscaleAsync(context.Background(), models.NewVideoUpscaleRequest("https://example.com/clip.mp4", 2))
+	if err != nil {
+		t.Fatalf("VideoUpscaleAsync() error = %v", err)
+	}
+	video, err := job.WithBackoff(fastJobBackoff()).Wait(context.Background())
+	if err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+	if video.VideoURL == nil || *video.VideoURL != "https://example.com/upscaled.mp4" {
+		t.Errorf("VideoURL = %v", video.VideoURL)
+	}
+}
+
+func TestVideoBackgroundRemovalJobFailure(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		return map[string]interface{}{"data": []interface{}{map[string]interface{}{
+			"taskType": models.TaskTypeVideoBackgroundRemoval,
+			"taskUUID": task["taskUUID"],
+			"status":   models.TaskStatusError,
+		}}}
+	})
+
+	_, err := client.VideoBackgroundRemovalJob(testUUID).WithBackoff(fastJobBackoff()).Wait(context.Background())
+	var jobErr *JobError
+	if !errors.As(err, &jobErr) {
+		t.Fatalf("Wait() error = %v, want *JobError", err)
+	}
+	if jobErr.TaskType != models.TaskTypeVideoBackgroundRemoval {
+		t.Errorf("TaskType = %q, want %q", jobErr.TaskType, models.TaskTypeVideoBackgroundRemoval)
+	}
+}
@@ -0,0 +1,192 @@
+package runware
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	wsinternal "github.com/Ryank90/runware-go-sdk/internal/ws"
+	models "github.com/Ryank90/runware-go-sdk/models"
+)
+
+// DefaultMaxUploadSize is the upload size limit used when Config.MaxUploadSize is zero.
+//
+// File and reader uploads are base64-encoded in chunks straight into the outgoing
+// message, so the limit guards against runaway readers rather than bounding memory.
+const DefaultMaxUploadSize int64 = 1 << 30 // 1 GiB
+
+// UploadMedia uploads a video or audio file to Runware. The returned MediaUUID can be
+// used as a ReferenceVideo or InputAudio in video inference requests.
+func (c *Client) UploadMedia(ctx context.Context, req *models.MediaUploadRequest) (*models.MediaUploadResponse, error) {
+	if req == nil || req.Media == "" {
+		return nil, ErrInvalidRequest
+	}
+
+	if req.TaskType == "" {
+		req.TaskType = models.TaskTypeMediaStorage
+	}
+	if req.Operation == "" {
+		req.Operation = models.MediaStorageOperationUpload
+	}
+
+	result, err := c.sendRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp, ok := result.(*models.MediaUploadResponse); ok {
+		return resp, nil
+	}
+	return nil, ErrInvalidResponse
+}
+
+// UploadVideoFromFile uploads a video from a file path.
+//
+// Example:
+//
+//	video, err := client.UploadVideoFromFile(ctx, "clip.mp4")
+//	req := runware.NewVideoRequestBuilder("a dancer", "bytedance:1@1").
+//	    WithReferenceVideo(video.MediaUUID).
+//	    Build()
+func (c *Client) UploadVideoFromFile(ctx context.Context, filePath string) (*models.MediaUploadResponse, error) {
+	return c.uploadMediaFromFile(ctx, filePath, "video/")
+}
+
+// UploadVideoFromURL uploads a video from a URL. The API downloads the video itself.
+func (c *Client) UploadVideoFromURL(ctx context.Context, url string) (*models.MediaUploadResponse, error) {
+	return c.uploadMediaFromURL(ctx, url)
+}
+
+// UploadVideoFromReader uploads a video read from r. If mimeType is empty it is
+// detected from the content.
+func (c *Client) UploadVideoFromReader(ctx context.Context, r io.Reader, mimeType string) (*models.MediaUploadResponse, error) {
+	return c.uploadMediaFromReader(ctx, r, mimeType, "video/")
+}
+
+// UploadAudioFromFile uploads an audio file from a file path.
+// Use the returned MediaUUID with VideoRequestBuilder.WithInputAudio.
+func (c *Client) UploadAudioFromFile(ctx context.Context, filePath string) (*models.MediaUploadResponse, error) {
+	return c.uploadMediaFromFile(ctx, filePath, "audio/")
+}
+
+// UploadAudioFromURL uploads an audio file from a URL. The API downloads the audio itself.
+func (c *Client) UploadAudioFromURL(ctx context.Context, url string) (*models.MediaUploadResponse, error) {
+	return c.uploadMediaFromURL(ctx, url)
+}
+
+// UploadAudioFromReader uploads audio read from r. If mimeType is empty it is
+// detected from the content.
+func (c *Client) UploadAudioFromReader(ctx context.Context, r io.Reader, mimeType string) (*models.MediaUploadResponse, error) {
+	return c.uploadMediaFromReader(ctx, r, mimeType, "audio/")
+}
+
+// uploadMediaFromURL uploads media the API downloads itself
+func (c *Client) uploadMediaFromURL(ctx context.Context, url string) (*models.MediaUploadResponse, error) {
+	return c.UploadMedia(ctx, models.NewMediaUploadRequest(url))
+}
+
+// uploadMediaFromFile streams a file, whose size is checked before it is read
+func (c *Client) uploadMediaFromFile(ctx context.Context, filePath, kind string) (*models.MediaUploadResponse, error) {
+	f, err := os.Open(filePath) // #nosec G304 - file path is provided by user for upload
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+	return c.uploadMediaFromReader(ctx, f, "", kind)
+}
+
+// uploadMediaFromReader uploads r as a data URI, encoding it into the outgoing message
+func (c *Client) uploadMediaFromReader(ctx context.Context, r io.Reader, mimeType, kind string) (*models.MediaUploadResponse, error) {
+	if r == nil {
+		return nil, ErrInvalidRequest
+	}
+
+	content, cleanup, err := sizeUpload(r, c.maxUploadSize())
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	br := bufio.NewReaderSize(content, 512)
+	if mimeType == "" {
+		// DetectContentType only needs the first 512 bytes
+		head, _ := br.Peek(512)
+		if detected := http.DetectContentType(head); strings.HasPrefix(detected, kind) {
+			mimeType = detected
+		}
+	}
+
+	streamed := &wsinternal.StreamedRequest{
+		Task:    models.NewMediaUploadRequest(""),
+		Field:   "media",
+		Content: br,
+	}
+	if mimeType != "" {
+		streamed.Prefix = "data:" + mimeType + ";base64,"
+	}
+
+	result, err := c.sendRequest(ctx, streamed)
+	if err != nil {
+		return nil, err
+	}
+	if resp, ok := result.(*models.MediaUploadResponse); ok {
+		return resp, nil
+	}
+	return nil, ErrInvalidResponse
+}
+
+// sizeUpload checks that r holds at most limit bytes before anything is sent. Files
+// and in-memory readers report their size; other readers are spooled to a temporary
+// file, which cleanup removes, so the limit holds without buffering them in memory.
+func sizeUpload(r io.Reader, limit int64) (io.Reader, func(), error) {
+	size := int64(-1)
+	switch v := r.(type) {
+	case interface{ Len() int }:
+		size = int64(v.Len())
+	case *os.File:
+		if info, err := v.Stat(); err == nil && info.Mode().IsRegular() {
+			if offset, err := v.Seek(0, io.SeekCurrent); err == nil {
+				size = info.Size() - offset
+			}
+		}
+	}
+	if size > limit {
+		return nil, nil, fmt.Errorf("%w: %d bytes (limit %d)", ErrPayloadTooLarge, size, limit)
+	}
+	if size >= 0 {
+		return r, func() {}, nil
+	}
+
+	tmp, err := os.CreateTemp("", "runware-upload-*")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to buffer upload: %w", err)
+	}
+	cleanup := func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}
+	n, err := io.Copy(tmp, io.LimitReader(r, limit+1))
+	if err == nil && n > limit {
+		err = fmt.Errorf("%w: more than %d bytes", ErrPayloadTooLarge, limit)
+	} else if err == nil {
+		_, err = tmp.Seek(0, io.SeekStart)
+	} else {
+		err = fmt.Errorf("failed to read upload: %w", err)
+	}
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	return tmp, cleanup, nil
+}
+
+// maxUploadSize returns the configured upload size limit
+func (c *Client) maxUploadSize() int64 {
+	if c.config != nil && c.config.MaxUploadSize > 0 {
+		return c.config.MaxUploadSize
+	}
+	return DefaultMaxUploadSize
+}
@@ -0,0 +1,134 @@
+package runware
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Ryank90/runware-go-sdk/models"
+)
+
+func mediaUploadResponse(task map[string]interface{}) interface{} {
+	return map[string]interface{}{"data": []interface{}{map[string]interface{}{
+		"taskType":  models.TaskTypeMediaStorage,
+		"taskUUID":  task["taskUUID"],
+		"mediaUUID": "media-uuid",
+	}}}
+}
+
+func TestUploadVideoFromURL(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		if task["taskType"] != models.TaskTypeMediaStorage || task["operation"] != "upload" {
+			t.Errorf("unexpected task: %v", task)
+		}
+		if task["media"] != "https://example.com/clip.mp4" {
+			t.Errorf("media = %v", task["media"])
+		}
+		return mediaUploadResponse(task)
+	})
+
+	resp, err := client.UploadVideoFromURL(context.Background(), "https://example.com/clip.mp4")
+	if err != nil {
+		t.Fatalf("UploadVideoFromURL() error = %v", err)
+	}
+	if resp.MediaUUID != "media-uuid" {
+		t.Errorf("MediaUUID = %q, want media-uuid", resp.MediaUUID)
+	}
+}
+
+func TestUploadAudioFromReaderDetectsMIMEType(t *testing.T) {
+	// A minimal WAV header is enough for content sniffing
+	wav := append([]byte("RIFF\x24\x00\x00\x00WAVEfmt "), make([]byte, 64)...)
+
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		want := "data:audio/wave;base64," + base64.StdEncoding.EncodeToString(wav)
+		if task["media"] != want {
+			t.Errorf("media = %v, want %v", task["media"], want)
+		}
+		return mediaUploadResponse(task)
+	})
+
+	if _, err := client.UploadAudioFromReader(context.Background(), bytes.NewReader(wav), ""); err != nil {
+		t.Fatalf("UploadAudioFromReader() error = %v", err)
+	}
+}
+
+func TestUploadVideoFromFile(t *testing.T) {
+	data := bytes.Repeat([]byte{0x01, 0x02, 0x03, 0x04}, 64<<10) // spans several message frames
+	path := filepath.Join(t.TempDir(), "clip.bin")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		// Unrecognized content is sent as plain base64 without a data URI prefix
+		if task["media"] != base64.StdEncoding.EncodeToString(data) {
+			t.Error("media does not match the base64-encoded file")
+		}
+		return mediaUploadResponse(task)
+	})
+
+	if _, err := client.UploadVideoFromFile(context.Background(), path); err != nil {
+		t.Fatalf("UploadVideoFromFile() error = %v", err)
+	}
+}
+
+func TestUploadMediaTooLarge(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		t.Error("oversized upload should not be sent")
+		return mediaUploadResponse(task)
+	})
+	client.config.MaxUploadSize = 10
+
+	_, err := client.UploadVideoFromReader(context.Background(), strings.NewReader(strings.Repeat("x", 11)), "video/mp4")
+	if !errors.Is(err, ErrPayloadTooLarge) {
+		t.Errorf("UploadVideoFromReader() error = %v, want ErrPayloadTooLarge", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "clip.mp4")
+	if err := os.WriteFile(path, make([]byte, 11), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.UploadVideoFromFile(context.Background(), path); !errors.Is(err, ErrPayloadTooLarge) {
+		t.Errorf("UploadVideoFromFile() error = %v, want ErrPayloadTooLarge", err)
+	}
+}
+
+func TestSizeUploadAtLimit(t *testing.T) {
+	// An unsized reader is spooled; content exactly at the limit is accepted
+	content, cleanup, err := sizeUpload(io.MultiReader(strings.NewReader("hello")), 5)
+	if err != nil {
+		t.Fatalf("sizeUpload() error = %v", err)
+	}
+	defer cleanup()
+	if got, _ := io.ReadAll(content); string(got) != "hello" {
+		t.Errorf("spooled content = %q, want hello", got)
+	}
+}
+
+func TestUploadFromUnsizedReaderTooLarge(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		t.Error("oversized upload should not be sent")
+		return nil
+	})
+	client.config.MaxUploadSize = 1 << 20
+
+	r := io.LimitReader(zeroReader{}, 2<<20)
+	if _, err := client.UploadAudioFromReader(context.Background(), r, "audio/wav"); !errors.Is(err, ErrPayloadTooLarge) {
+		t.Errorf("UploadAudioFromReader() error = %v, want ErrPayloadTooLarge", err)
+	}
+}
+
+// zeroReader is an endless source of zero bytes
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
@@ -0,0 +1,51 @@
+package models
+
+// AccountOperation selects the account management operation
+type AccountOperation string
+
+const (
+	AccountOperationGetDetails AccountOperation = "getDetails"
+)
+
+type AccountManagementRequest struct {
+	TaskType  string           `json:"taskType"`
+	TaskUUID  string           `json:"taskUUID"`
+	Operation AccountOperation `json:"operation"`
+}
+
+type AccountManagementResponse struct {
+	TaskType         string          `json:"taskType"`
+	TaskUUID         string          `json:"taskUUID"`
+	OrganizationUUID string          `json:"organizationUUID,omitempty"`
+	OrganizationName string          `json:"organizationName,omitempty"`
+	Balance          float64         `json:"balance"`
+	Usage            []UsagePeriod   `json:"usage,omitempty"`
+	APIKeys          []APIKeyDetails `json:"apiKeys,omitempty"`
+}
+
+// UsagePeriod reports spend and request volume over a period (e.g. "last24h", "last7d", "last30d")
+type UsagePeriod struct {
+	Period   string  `json:"period"`
+	Credits  float64 `json:"credits"`
+	Requests int     `json:"requests"`
+}
+
+// APIKeyDetails describes an API key of the account
+type APIKeyDetails struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	Enabled     bool    `json:"enabled"`
+	CreatedAt   *string `json:"createdAt,omitempty"`
+	LastUsedAt  *string `json:"lastUsedAt,omitempty"`
+	Requests    int     `json:"requests,omitempty"`
+}
+
+// UsageFor returns the usage reported for the named period, if any
+func (r *AccountManagementResponse) UsageFor(period string) (UsagePeriod, bool) {
+	for _, u := range r.Usage {
+		if u.Period == period {
+			return u, true
+		}
+	}
+	return UsagePeriod{}, false
+}
@@ -12,6 +12,10 @@ func NewUploadImageRequest() *UploadImageRequest {
 	return &UploadImageRequest{TaskType: TaskTypeImageUpload, TaskUUID: uuid.New().String()}
 }
 
+func NewMediaUploadRequest(media string) *MediaUploadRequest {
+	return &MediaUploadRequest{TaskType: TaskTypeMediaStorage, TaskUUID: uuid.New().String(), Operation: MediaStorageOperationUpload, Media: media}
+}
+
 func NewUpscaleGanRequest(inputImage string, upscaleFactor int) *UpscaleGanRequest {
 	return &UpscaleGanRequest{TaskType: TaskTypeUpscaleGan, TaskUUID: uuid.New().String(), InputImage: inputImage, UpscaleFactor: upscaleFactor}
 }
@@ -28,12 +32,58 @@ func NewImageCaptionRequest(inputImage string) *ImageCaptionRequest {
 	return &ImageCaptionRequest{TaskType: TaskTypeImageCaption, TaskUUID: uuid.New().String(), InputImage: inputImage}
 }
 
+func NewControlNetPreprocessRequest(inputImage string, preprocessor ControlNetPreprocessor) *ControlNetPreprocessRequest {
+	return &ControlNetPreprocessRequest{TaskType: TaskTypeControlNetPreprocess, TaskUUID: uuid.New().String(), InputImage: inputImage, PreProcessorType: preprocessor}
+}
+
+func NewImageMaskingRequest(inputImage, model string) *ImageMaskingRequest {
+	return &ImageMaskingRequest{TaskType: TaskTypeImageMasking, TaskUUID: uuid.New().String(), InputImage: inputImage, Model: model}
+}
+
+func NewPhotoMakerRequest(inputImages []string, prompt, model string, width, height int) *PhotoMakerRequest {
+	return &PhotoMakerRequest{TaskType: TaskTypePhotoMaker, TaskUUID: uuid.New().String(), InputImages: inputImages, PositivePrompt: prompt, Model: model, Width: width, Height: height}
+}
+
+func NewVectorizeRequest(inputImage string) *VectorizeRequest {
+	format := OutputFormatSVG
+	return &VectorizeRequest{TaskType: TaskTypeVectorize, TaskUUID: uuid.New().String(), Model: VectorizeModelRecraft, Inputs: VectorizeInputs{Image: inputImage}, OutputFormat: &format}
+}
+
+// NewVideoInferenceRequest creates a video request with the defaults registered for
+// the model (see LookupVideoCapabilities). Models that are not registered default to
+// 1920x1080 at 30 fps.
 func NewVideoInferenceRequest(prompt, model string) *VideoInferenceRequest {
-	width, height, fps := 1920, 1080, 30
 	numberResults := 1
 	outputType := OutputTypeURL
 	delivery := DeliveryMethodAsync
-	return &VideoInferenceRequest{TaskType: TaskTypeVideoInference, TaskUUID: uuid.New().String(), PositivePrompt: prompt, Model: model, Width: &width, Height: &height, FPS: &fps, NumberResults: &numberResults, OutputType: &outputType, DeliveryMethod: &delivery}
+	req := &VideoInferenceRequest{TaskType: TaskTypeVideoInference, TaskUUID: uuid.New().String(), PositivePrompt: prompt, Model: model, NumberResults: &numberResults, OutputType: &outputType, DeliveryMethod: &delivery}
+
+	defaults := &legacyVideoDefaults
+	if caps, ok := LookupVideoCapabilities(model); ok {
+		defaults = caps
+	}
+	if res := defaults.DefaultResolution; res.Width > 0 && res.Height > 0 {
+		req.Width, req.Height = &res.Width, &res.Height
+	}
+	if defaults.DefaultDuration > 0 {
+		duration := defaults.DefaultDuration
+		req.Duration = &duration
+	}
+	if defaults.DefaultFPS > 0 {
+		fps := defaults.DefaultFPS
+		req.FPS = &fps
+	}
+	return req
+}
+
+func NewVideoUpscaleRequest(inputVideo string, upscaleFactor int) *VideoUpscaleRequest {
+	delivery := DeliveryMethodAsync
+	return &VideoUpscaleRequest{TaskType: TaskTypeVideoUpscale, TaskUUID: uuid.New().String(), InputVideo: inputVideo, UpscaleFactor: upscaleFactor, DeliveryMethod: &delivery}
+}
+
+func NewVideoBackgroundRemovalRequest(inputVideo string) *VideoBackgroundRemovalRequest {
+	delivery := DeliveryMethodAsync
+	return &VideoBackgroundRemovalRequest{TaskType: TaskTypeVideoBackgroundRemoval, TaskUUID: uuid.New().String(), InputVideo: inputVideo, DeliveryMethod: &delivery}
 }
 
 func NewAudioInferenceRequest(prompt, model string, duration int) *AudioInferenceRequest {
@@ -44,6 +94,55 @@ func NewAudioInferenceRequest(prompt, model string, duration int) *AudioInferenc
 	return &AudioInferenceRequest{TaskType: TaskTypeAudioInference, TaskUUID: uuid.New().String(), PositivePrompt: prompt, Model: model, Duration: &duration, OutputType: &outputType, OutputFormat: &format, DeliveryMethod: &delivery, NumberResults: &numberResults}
 }
 
+func NewModelSearchRequest(search string) *ModelSearchRequest {
+	req := &ModelSearchRequest{TaskType: TaskTypeModelSearch, TaskUUID: uuid.New().String()}
+	if search != "" {
+		req.Search = &search
+	}
+	return req
+}
+
+// newModelUploadRequest sets the fields shared by every model upload
+func newModelUploadRequest(category ModelCategory, air, name, version, downloadURL, architecture string) *ModelUploadRequest {
+	return &ModelUploadRequest{
+		TaskType:         TaskTypeModelUpload,
+		TaskUUID:         uuid.New().String(),
+		Category:         category,
+		AIR:              air,
+		Name:             name,
+		Version:          version,
+		DownloadURL:      downloadURL,
+		UniqueIdentifier: uuid.New().String(),
+		Architecture:     architecture,
+		Format:           ModelFormatSafetensors,
+		Private:          true,
+	}
+}
+
+func NewCheckpointUploadRequest(air, name, version, downloadURL, architecture string, modelType ModelType) *ModelUploadRequest {
+	req := newModelUploadRequest(ModelCategoryCheckpoint, air, name, version, downloadURL, architecture)
+	req.Type = &modelType
+	return req
+}
+
+func NewLoRAUploadRequest(air, name, version, downloadURL, architecture string) *ModelUploadRequest {
+	return newModelUploadRequest(ModelCategoryLoRA, air, name, version, downloadURL, architecture)
+}
+
+func NewControlNetUploadRequest(air, name, version, downloadURL, architecture, conditioning string) *ModelUploadRequest {
+	req := newModelUploadRequest(ModelCategoryControlNet, air, name, version, downloadURL, architecture)
+	req.Conditioning = &conditioning
+	return req
+}
+
+func NewEmbeddingUploadRequest(air, name, version, downloadURL, architecture string) *ModelUploadRequest {
+	return newModelUploadRequest(ModelCategoryEmbeddings, air, name, version, downloadURL, architecture)
+}
+
+func NewAccountDetailsRequest() *AccountManagementRequest {
+	return &AccountManagementRequest{TaskType: TaskTypeAccountManagement, TaskUUID: uuid.New().String(), Operation: AccountOperationGetDetails}
+}
+
 type GetResponseRequest struct {
 	TaskType string `json:"taskType"`
 	TaskUUID string `json:"taskUUID"`
@@ -0,0 +1,80 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"  // register GIF for image.Decode
+	_ "image/jpeg" // register JPEG for image.Decode
+	_ "image/png"  // register PNG for image.Decode
+	"io"
+	"sync"
+)
+
+// ErrUnsupportedImageFormat is returned by Decode when no decoder is available for
+// the image format. Use RegisterImageDecoder to add one (e.g. for WEBP).
+var ErrUnsupportedImageFormat = errors.New("unsupported image format")
+
+// ImageDecoder decodes an image from r
+type ImageDecoder func(r io.Reader) (image.Image, error)
+
+var (
+	decodersMu sync.RWMutex
+	decoders   = map[string]ImageDecoder{}
+)
+
+// RegisterImageDecoder registers a decoder used by Decode for a content type.
+// PNG, JPEG and GIF are supported out of the box, as is any format registered
+// with image.RegisterFormat. A nil decoder removes the registration.
+// WEBP needs a decoder, for example:
+//
+//	import "golang.org/x/image/webp"
+//
+//	models.RegisterImageDecoder("image/webp", webp.Decode)
+func RegisterImageDecoder(contentType string, decoder ImageDecoder) {
+	decodersMu.Lock()
+	defer decodersMu.Unlock()
+	if decoder == nil {
+		delete(decoders, contentType)
+		return
+	}
+	decoders[contentType] = decoder
+}
+
+// decode opens the output and decodes it, preferring a registered decoder for its content type
+func (o mediaOutput) decode(ctx context.Context, opts []DownloadOption) (image.Image, error) {
+	body, contentType, err := o.open(ctx, newDownloadOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	decodersMu.RLock()
+	decoder := decoders[contentType]
+	decodersMu.RUnlock()
+	if decoder != nil {
+		return decoder(body)
+	}
+
+	img, _, err := image.Decode(body)
+	if errors.Is(err, image.ErrFormat) {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedImageFormat, contentType)
+	}
+	return img, err
+}
+
+// Decode returns the generated image as an image.Image
+func (r *ImageInferenceResponse) Decode(ctx context.Context, opts ...DownloadOption) (image.Image, error) {
+	return r.output().decode(ctx, opts)
+}
+
+// Decode returns the upscaled image as an image.Image
+func (r *UpscaleGanResponse) Decode(ctx context.Context, opts ...DownloadOption) (image.Image, error) {
+	return r.output().decode(ctx, opts)
+}
+
+// Decode returns the image with its background removed as an image.Image
+func (r *RemoveImageBackgroundResponse) Decode(ctx context.Context, opts ...DownloadOption) (image.Image, error) {
+	return r.output().decode(ctx, opts)
+}
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func encodeTestImage(t *testing.T, encode func(io.Writer, image.Image) error) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, 4, 3))
+	img.Set(1, 1, color.RGBA{R: 255, A: 255})
+	var buf bytes.Buffer
+	if err := encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestDecodeOutputs(t *testing.T) {
+	pngData := encodeTestImage(t, png.Encode)
+	jpegData := encodeTestImage(t, func(w io.Writer, img image.Image) error { return jpeg.Encode(w, img, nil) })
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(jpegData)
+	}))
+	defer server.Close()
+
+	base64PNG := base64.StdEncoding.EncodeToString(pngData)
+	dataURI := "data:image/png;base64," + base64PNG
+
+	tests := []struct {
+		name string
+		resp interface {
+			Decode(context.Context, ...DownloadOption) (image.Image, error)
+		}
+	}{
+		{"URL", &ImageInferenceResponse{ImageURL: strPtr(server.URL + "/img.jpg")}},
+		{"Base64Data", &UpscaleGanResponse{ImageBase64Data: &base64PNG}},
+		{"DataURI", &RemoveImageBackgroundResponse{ImageDataURI: &dataURI}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			img, err := tt.resp.Decode(context.Background())
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			if b := img.Bounds(); b.Dx() != 4 || b.Dy() != 3 {
+				t.Errorf("Decode() bounds = %v, want 4x3", b)
+			}
+		})
+	}
+}
+
+func TestDecodeWEBPNeedsDecoder(t *testing.T) {
+	webp := "data:image/webp;base64," + base64.StdEncoding.EncodeToString([]byte("RIFF\x00\x00\x00\x00WEBPVP8 "))
+	resp := &ImageInferenceResponse{ImageDataURI: &webp}
+
+	if _, err := resp.Decode(context.Background()); !errors.Is(err, ErrUnsupportedImageFormat) {
+		t.Fatalf("Decode() error = %v, want ErrUnsupportedImageFormat", err)
+	}
+
+	want := image.NewGray(image.Rect(0, 0, 2, 2))
+	RegisterImageDecoder("image/webp", func(r io.Reader) (image.Image, error) { return want, nil })
+	defer RegisterImageDecoder("image/webp", nil)
+
+	img, err := resp.Decode(context.Background())
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if img != want {
+		t.Error("Decode() should use the registered decoder")
+	}
+}
@@ -4,9 +4,16 @@
 // used to interact with the Runware API. Types are organized by domain:
 //
 //   - image_types.go: Image generation, upload, upscaling, background removal
-//   - video_types.go: Video generation and configuration
+//   - video_types.go: Video generation, upscaling, background removal
+//   - video_capabilities.go: Per-model video capabilities and defaults
 //   - audio_types.go: Audio/music generation
+//   - media_types.go: Video and audio uploads
+//   - model_types.go: Model search and custom model upload
+//   - account_types.go: Account balance, usage and API keys
 //   - shared_types.go: Common types, enums, and constants
+//   - output.go: Open and Save helpers for generated media
+//   - decode.go: Decoding image results to image.Image
+//   - validation.go: Local request validation
 //   - constructors.go: Helper functions to create properly initialized requests
 //
 // # Request Constructors
@@ -83,6 +90,17 @@
 //	    fmt.Printf("Cost: $%.4f\n", *resp.Cost)
 //	}
 //
+// # Saving Outputs
+//
+// Responses that carry generated media implement Downloadable. Save handles URL,
+// base64 and data URI outputs alike, writing the file atomically and naming it by
+// its UUID and output format:
+//
+//	path, err := models.Save(ctx, resp, "./output") // e.g. output/<imageUUID>.webp
+//
+// Downloads give up after DefaultDownloadTimeout; pass WithHTTPClient to use a
+// client of your own.
+//
 // # Advanced Features
 //
 // The package supports advanced AI features:
@@ -147,3 +147,178 @@ type ImageCaptionResponse struct {
 	Text     string   `json:"text"`
 	Cost     *float64 `json:"cost,omitempty"`
 }
+
+// ControlNetPreprocessor selects the guide image produced by ControlNet preprocessing
+type ControlNetPreprocessor string
+
+const (
+	ControlNetPreprocessorCanny        ControlNetPreprocessor = "canny"
+	ControlNetPreprocessorDepth        ControlNetPreprocessor = "depth"
+	ControlNetPreprocessorMLSD         ControlNetPreprocessor = "mlsd"
+	ControlNetPreprocessorNormalBAE    ControlNetPreprocessor = "normalbae"
+	ControlNetPreprocessorOpenPose     ControlNetPreprocessor = "openpose"
+	ControlNetPreprocessorTile         ControlNetPreprocessor = "tile"
+	ControlNetPreprocessorSeg          ControlNetPreprocessor = "seg"
+	ControlNetPreprocessorLineArt      ControlNetPreprocessor = "lineart"
+	ControlNetPreprocessorLineArtAnime ControlNetPreprocessor = "lineart_anime"
+	ControlNetPreprocessorShuffle      ControlNetPreprocessor = "shuffle"
+	ControlNetPreprocessorScribble     ControlNetPreprocessor = "scribble"
+	ControlNetPreprocessorSoftEdge     ControlNetPreprocessor = "softedge"
+)
+
+type ControlNetPreprocessRequest struct {
+	TaskType                    string                 `json:"taskType"`
+	TaskUUID                    string                 `json:"taskUUID"`
+	InputImage                  string                 `json:"inputImage"`
+	PreProcessorType            ControlNetPreprocessor `json:"preProcessorType"`
+	Width                       *int                   `json:"width,omitempty"`
+	Height                      *int                   `json:"height,omitempty"`
+	LowThresholdCanny           *int                   `json:"lowThresholdCanny,omitempty"`
+	HighThresholdCanny          *int                   `json:"highThresholdCanny,omitempty"`
+	IncludeHandsAndFaceOpenPose *bool                  `json:"includeHandsAndFaceOpenPose,omitempty"`
+	OutputType                  *OutputType            `json:"outputType,omitempty"`
+	OutputFormat                *OutputFormat          `json:"outputFormat,omitempty"`
+	OutputQuality               *int                   `json:"outputQuality,omitempty"`
+	WebhookURL                  *string                `json:"webhookURL,omitempty"`
+	IncludeCost                 *bool                  `json:"includeCost,omitempty"`
+}
+
+type ControlNetPreprocessResponse struct {
+	TaskType             string   `json:"taskType"`
+	TaskUUID             string   `json:"taskUUID"`
+	InputImageUUID       string   `json:"inputImageUUID,omitempty"`
+	GuideImageUUID       string   `json:"guideImageUUID"`
+	GuideImageURL        *string  `json:"guideImageURL,omitempty"`
+	GuideImageBase64Data *string  `json:"guideImageBase64Data,omitempty"`
+	GuideImageDataURI    *string  `json:"guideImageDataURI,omitempty"`
+	Cost                 *float64 `json:"cost,omitempty"`
+}
+
+// Detection models for ImageMaskingRequest.Model
+const (
+	MaskingModelFaceYOLOv8n   = "runware:35@1"
+	MaskingModelFaceYOLOv8s   = "runware:35@2"
+	MaskingModelHandYOLOv8n   = "runware:35@3"
+	MaskingModelPersonYOLOv8n = "runware:35@4"
+	MaskingModelPersonYOLOv8s = "runware:35@5"
+)
+
+type ImageMaskingRequest struct {
+	TaskType      string        `json:"taskType"`
+	TaskUUID      string        `json:"taskUUID"`
+	InputImage    string        `json:"inputImage"`
+	Model         string        `json:"model"`
+	Confidence    *float64      `json:"confidence,omitempty"`
+	MaxDetections *int          `json:"maxDetections,omitempty"`
+	MaskPadding   *int          `json:"maskPadding,omitempty"`
+	MaskBlur      *int          `json:"maskBlur,omitempty"`
+	OutputType    *OutputType   `json:"outputType,omitempty"`
+	OutputFormat  *OutputFormat `json:"outputFormat,omitempty"`
+	OutputQuality *int          `json:"outputQuality,omitempty"`
+	WebhookURL    *string       `json:"webhookURL,omitempty"`
+	IncludeCost   *bool         `json:"includeCost,omitempty"`
+}
+
+// Detection is the bounding box of an object found by image masking, in pixels
+type Detection struct {
+	XMin int `json:"x_min"`
+	YMin int `json:"y_min"`
+	XMax int `json:"x_max"`
+	YMax int `json:"y_max"`
+}
+
+type ImageMaskingResponse struct {
+	TaskType            string      `json:"taskType"`
+	TaskUUID            string      `json:"taskUUID"`
+	InputImageUUID      string      `json:"inputImageUUID,omitempty"`
+	MaskImageUUID       string      `json:"maskImageUUID"`
+	MaskImageURL        *string     `json:"maskImageURL,omitempty"`
+	MaskImageBase64Data *string     `json:"maskImageBase64Data,omitempty"`
+	MaskImageDataURI    *string     `json:"maskImageDataURI,omitempty"`
+	Detections          []Detection `json:"detections,omitempty"`
+	Cost                *float64    `json:"cost,omitempty"`
+}
+
+// PhotoMakerStyle selects the style preset applied by PhotoMaker
+type PhotoMakerStyle string
+
+const (
+	PhotoMakerStyleNone            PhotoMakerStyle = "No style"
+	PhotoMakerStyleCinematic       PhotoMakerStyle = "Cinematic"
+	PhotoMakerStyleDisneyCharacter PhotoMakerStyle = "Disney Character"
+	PhotoMakerStyleDigitalArt      PhotoMakerStyle = "Digital Art"
+	PhotoMakerStylePhotographic    PhotoMakerStyle = "Photographic"
+	PhotoMakerStyleFantasyArt      PhotoMakerStyle = "Fantasy art"
+	PhotoMakerStyleNeonpunk        PhotoMakerStyle = "Neonpunk"
+	PhotoMakerStyleEnhance         PhotoMakerStyle = "Enhance"
+	PhotoMakerStyleComicBook       PhotoMakerStyle = "Comic book"
+	PhotoMakerStyleLowpoly         PhotoMakerStyle = "Lowpoly"
+	PhotoMakerStyleLineArt         PhotoMakerStyle = "Line art"
+)
+
+// PhotoMakerTriggerWord must appear in a PhotoMaker prompt where the subject's identity is placed
+const PhotoMakerTriggerWord = "rwre"
+
+type PhotoMakerRequest struct {
+	TaskType       string           `json:"taskType"`
+	TaskUUID       string           `json:"taskUUID"`
+	InputImages    []string         `json:"inputImages"`
+	PositivePrompt string           `json:"positivePrompt"`
+	NegativePrompt *string          `json:"negativePrompt,omitempty"`
+	Model          string           `json:"model"`
+	Width          int              `json:"width"`
+	Height         int              `json:"height"`
+	Style          *PhotoMakerStyle `json:"style,omitempty"`
+	Strength       *int             `json:"strength,omitempty"`
+	Steps          *int             `json:"steps,omitempty"`
+	CFGScale       *float64         `json:"CFGScale,omitempty"`
+	Scheduler      *Scheduler       `json:"scheduler,omitempty"`
+	Seed           *int64           `json:"seed,omitempty"`
+	NumberResults  *int             `json:"numberResults,omitempty"`
+	OutputType     *OutputType      `json:"outputType,omitempty"`
+	OutputFormat   *OutputFormat    `json:"outputFormat,omitempty"`
+	OutputQuality  *int             `json:"outputQuality,omitempty"`
+	WebhookURL     *string          `json:"webhookURL,omitempty"`
+	IncludeCost    *bool            `json:"includeCost,omitempty"`
+}
+
+type PhotoMakerResponse struct {
+	TaskType        string   `json:"taskType"`
+	TaskUUID        string   `json:"taskUUID"`
+	ImageUUID       string   `json:"imageUUID"`
+	ImageURL        *string  `json:"imageURL,omitempty"`
+	ImageBase64Data *string  `json:"imageBase64Data,omitempty"`
+	ImageDataURI    *string  `json:"imageDataURI,omitempty"`
+	Seed            *int64   `json:"seed,omitempty"`
+	NSFWContent     *bool    `json:"NSFWContent,omitempty"`
+	Cost            *float64 `json:"cost,omitempty"`
+}
+
+// VectorizeModelRecraft is the default vectorization model
+const VectorizeModelRecraft = "recraft:1@1"
+
+// VectorizeInputs holds the image to convert
+type VectorizeInputs struct {
+	Image string `json:"image"`
+}
+
+type VectorizeRequest struct {
+	TaskType     string          `json:"taskType"`
+	TaskUUID     string          `json:"taskUUID"`
+	Model        string          `json:"model"`
+	Inputs       VectorizeInputs `json:"inputs"`
+	OutputType   *OutputType     `json:"outputType,omitempty"`
+	OutputFormat *OutputFormat   `json:"outputFormat,omitempty"`
+	WebhookURL   *string         `json:"webhookURL,omitempty"`
+	IncludeCost  *bool           `json:"includeCost,omitempty"`
+}
+
+type VectorizeResponse struct {
+	TaskType        string   `json:"taskType"`
+	TaskUUID        string   `json:"taskUUID"`
+	ImageUUID       string   `json:"imageUUID"`
+	ImageURL        *string  `json:"imageURL,omitempty"`
+	ImageBase64Data *string  `json:"imageBase64Data,omitempty"`
+	ImageDataURI    *string  `json:"imageDataURI,omitempty"`
+	Cost            *float64 `json:"cost,omitempty"`
+}
@@ -7,38 +7,109 @@ type TaskIdentifiable interface {
 }
 type ResultCountProvider interface{ GetNumberResults() *int }
 
+// TaskUUIDSetter is implemented by requests that can be re-submitted under a fresh
+// TaskUUID (e.g. when retrying). GetResponseRequest deliberately does not implement
+// it, since its TaskUUID identifies the task being polled.
+type TaskUUIDSetter interface{ SetTaskUUID(taskUUID string) }
+
 // Implementations on request types
 func (r *ImageInferenceRequest) GetTaskUUID() string    { return r.TaskUUID }
 func (r *ImageInferenceRequest) GetTaskType() string    { return r.TaskType }
+func (r *ImageInferenceRequest) SetTaskUUID(id string)  { r.TaskUUID = id }
 func (r *ImageInferenceRequest) GetNumberResults() *int { return r.NumberResults }
 
-func (r *UploadImageRequest) GetTaskUUID() string { return r.TaskUUID }
-func (r *UploadImageRequest) GetTaskType() string { return r.TaskType }
+func (r *UploadImageRequest) GetTaskUUID() string   { return r.TaskUUID }
+func (r *UploadImageRequest) GetTaskType() string   { return r.TaskType }
+func (r *UploadImageRequest) SetTaskUUID(id string) { r.TaskUUID = id }
+
+func (r *MediaUploadRequest) GetTaskUUID() string   { return r.TaskUUID }
+func (r *MediaUploadRequest) GetTaskType() string   { return r.TaskType }
+func (r *MediaUploadRequest) SetTaskUUID(id string) { r.TaskUUID = id }
 
 func (r *UpscaleGanRequest) GetTaskUUID() string    { return r.TaskUUID }
 func (r *UpscaleGanRequest) GetTaskType() string    { return r.TaskType }
+func (r *UpscaleGanRequest) SetTaskUUID(id string)  { r.TaskUUID = id }
 func (r *UpscaleGanRequest) GetNumberResults() *int { return nil }
 
 func (r *RemoveImageBackgroundRequest) GetTaskUUID() string    { return r.TaskUUID }
 func (r *RemoveImageBackgroundRequest) GetTaskType() string    { return r.TaskType }
+func (r *RemoveImageBackgroundRequest) SetTaskUUID(id string)  { r.TaskUUID = id }
 func (r *RemoveImageBackgroundRequest) GetNumberResults() *int { return nil }
 
 func (r *EnhancePromptRequest) GetTaskUUID() string    { return r.TaskUUID }
 func (r *EnhancePromptRequest) GetTaskType() string    { return r.TaskType }
+func (r *EnhancePromptRequest) SetTaskUUID(id string)  { r.TaskUUID = id }
 func (r *EnhancePromptRequest) GetNumberResults() *int { return nil }
 
 func (r *ImageCaptionRequest) GetTaskUUID() string    { return r.TaskUUID }
 func (r *ImageCaptionRequest) GetTaskType() string    { return r.TaskType }
+func (r *ImageCaptionRequest) SetTaskUUID(id string)  { r.TaskUUID = id }
 func (r *ImageCaptionRequest) GetNumberResults() *int { return nil }
 
+func (r *ControlNetPreprocessRequest) GetTaskUUID() string    { return r.TaskUUID }
+func (r *ControlNetPreprocessRequest) GetTaskType() string    { return r.TaskType }
+func (r *ControlNetPreprocessRequest) SetTaskUUID(id string)  { r.TaskUUID = id }
+func (r *ControlNetPreprocessRequest) GetNumberResults() *int { return nil }
+
+func (r *ImageMaskingRequest) GetTaskUUID() string    { return r.TaskUUID }
+func (r *ImageMaskingRequest) GetTaskType() string    { return r.TaskType }
+func (r *ImageMaskingRequest) SetTaskUUID(id string)  { r.TaskUUID = id }
+func (r *ImageMaskingRequest) GetNumberResults() *int { return nil }
+
+func (r *PhotoMakerRequest) GetTaskUUID() string    { return r.TaskUUID }
+func (r *PhotoMakerRequest) GetTaskType() string    { return r.TaskType }
+func (r *PhotoMakerRequest) SetTaskUUID(id string)  { r.TaskUUID = id }
+func (r *PhotoMakerRequest) GetNumberResults() *int { return r.NumberResults }
+
+func (r *VectorizeRequest) GetTaskUUID() string    { return r.TaskUUID }
+func (r *VectorizeRequest) GetTaskType() string    { return r.TaskType }
+func (r *VectorizeRequest) SetTaskUUID(id string)  { r.TaskUUID = id }
+func (r *VectorizeRequest) GetNumberResults() *int { return nil }
+
 func (r *VideoInferenceRequest) GetTaskUUID() string    { return r.TaskUUID }
 func (r *VideoInferenceRequest) GetTaskType() string    { return r.TaskType }
+func (r *VideoInferenceRequest) SetTaskUUID(id string)  { r.TaskUUID = id }
 func (r *VideoInferenceRequest) GetNumberResults() *int { return r.NumberResults }
 
+func (r *VideoUpscaleRequest) GetTaskUUID() string    { return r.TaskUUID }
+func (r *VideoUpscaleRequest) GetTaskType() string    { return r.TaskType }
+func (r *VideoUpscaleRequest) SetTaskUUID(id string)  { r.TaskUUID = id }
+func (r *VideoUpscaleRequest) GetNumberResults() *int { return nil }
+
+func (r *VideoBackgroundRemovalRequest) GetTaskUUID() string    { return r.TaskUUID }
+func (r *VideoBackgroundRemovalRequest) GetTaskType() string    { return r.TaskType }
+func (r *VideoBackgroundRemovalRequest) SetTaskUUID(id string)  { r.TaskUUID = id }
+func (r *VideoBackgroundRemovalRequest) GetNumberResults() *int { return nil }
+
 func (r *AudioInferenceRequest) GetTaskUUID() string    { return r.TaskUUID }
 func (r *AudioInferenceRequest) GetTaskType() string    { return r.TaskType }
+func (r *AudioInferenceRequest) SetTaskUUID(id string)  { r.TaskUUID = id }
 func (r *AudioInferenceRequest) GetNumberResults() *int { return r.NumberResults }
 
+func (r *ModelSearchRequest) GetTaskUUID() string    { return r.TaskUUID }
+func (r *ModelSearchRequest) GetTaskType() string    { return r.TaskType }
+func (r *ModelSearchRequest) SetTaskUUID(id string)  { r.TaskUUID = id }
+func (r *ModelSearchRequest) GetNumberResults() *int { return nil }
+
+func (r *ModelUploadRequest) GetTaskUUID() string    { return r.TaskUUID }
+func (r *ModelUploadRequest) GetTaskType() string    { return r.TaskType }
+func (r *ModelUploadRequest) SetTaskUUID(id string)  { r.TaskUUID = id }
+func (r *ModelUploadRequest) GetNumberResults() *int { return nil }
+
+func (r *AccountManagementRequest) GetTaskUUID() string    { return r.TaskUUID }
+func (r *AccountManagementRequest) GetTaskType() string    { return r.TaskType }
+func (r *AccountManagementRequest) SetTaskUUID(id string)  { r.TaskUUID = id }
+func (r *AccountManagementRequest) GetNumberResults() *int { return nil }
+
 func (r *GetResponseRequest) GetTaskUUID() string    { return r.TaskUUID }
 func (r *GetResponseRequest) GetTaskType() string    { return r.TaskType }
 func (r *GetResponseRequest) GetNumberResults() *int { return nil }
+
+// AsyncResult is implemented by responses of tasks that complete asynchronously
+// and report their progress through a status field.
+type AsyncResult interface{ GetStatus() TaskStatus }
+
+func (r *VideoInferenceResponse) GetStatus() TaskStatus         { return r.Status }
+func (r *AudioInferenceResponse) GetStatus() TaskStatus         { return r.Status }
+func (r *VideoUpscaleResponse) GetStatus() TaskStatus           { return r.Status }
+func (r *VideoBackgroundRemovalResponse) GetStatus() TaskStatus { return r.Status }
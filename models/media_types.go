@@ -0,0 +1,23 @@
+package models
+
+// MediaStorageOperation selects the media storage operation
+type MediaStorageOperation string
+
+const (
+	MediaStorageOperationUpload MediaStorageOperation = "upload"
+)
+
+// MediaUploadRequest uploads a video or audio file for use as a task input.
+// Media may be a public URL, a data URI or plain base64-encoded data.
+type MediaUploadRequest struct {
+	TaskType  string                `json:"taskType"`
+	TaskUUID  string                `json:"taskUUID"`
+	Operation MediaStorageOperation `json:"operation"`
+	Media     string                `json:"media,omitempty"`
+}
+
+type MediaUploadResponse struct {
+	TaskType  string `json:"taskType"`
+	TaskUUID  string `json:"taskUUID"`
+	MediaUUID string `json:"mediaUUID"`
+}
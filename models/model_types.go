@@ -0,0 +1,141 @@
+package models
+
+// ModelCategory filters model search results by kind of model
+type ModelCategory string
+
+const (
+	ModelCategoryCheckpoint ModelCategory = "checkpoint"
+	ModelCategoryLoRA       ModelCategory = "lora"
+	ModelCategoryLyCORIS    ModelCategory = "lycoris"
+	ModelCategoryControlNet ModelCategory = "controlnet"
+	ModelCategoryVAE        ModelCategory = "vae"
+	ModelCategoryEmbeddings ModelCategory = "embeddings"
+)
+
+// ModelType filters checkpoint models by purpose
+type ModelType string
+
+const (
+	ModelTypeBase       ModelType = "base"
+	ModelTypeInpainting ModelType = "inpainting"
+	ModelTypeRefiner    ModelType = "refiner"
+)
+
+// ModelVisibility selects public models, your private models, or both
+type ModelVisibility string
+
+const (
+	ModelVisibilityPublic  ModelVisibility = "public"
+	ModelVisibilityPrivate ModelVisibility = "private"
+	ModelVisibilityAll     ModelVisibility = "all"
+)
+
+// ModelSearchMaxLimit is the largest page size accepted by the API
+const ModelSearchMaxLimit = 100
+
+type ModelSearchRequest struct {
+	TaskType     string           `json:"taskType"`
+	TaskUUID     string           `json:"taskUUID"`
+	Search       *string          `json:"search,omitempty"`
+	Tags         []string         `json:"tags,omitempty"`
+	Category     *ModelCategory   `json:"category,omitempty"`
+	Type         *ModelType       `json:"type,omitempty"`
+	Architecture *string          `json:"architecture,omitempty"`
+	Conditioning *string          `json:"conditioning,omitempty"`
+	Visibility   *ModelVisibility `json:"visibility,omitempty"`
+	Limit        *int             `json:"limit,omitempty"`
+	Offset       *int             `json:"offset,omitempty"`
+}
+
+type ModelSearchResponse struct {
+	TaskType     string        `json:"taskType"`
+	TaskUUID     string        `json:"taskUUID"`
+	Results      []ModelResult `json:"results"`
+	TotalResults int           `json:"totalResults"`
+}
+
+// ModelResult describes a model returned by model search
+type ModelResult struct {
+	Name                 string        `json:"name"`
+	AIR                  string        `json:"air"`
+	Version              string        `json:"version,omitempty"`
+	Category             ModelCategory `json:"category,omitempty"`
+	Architecture         string        `json:"architecture,omitempty"`
+	Type                 ModelType     `json:"type,omitempty"`
+	Conditioning         string        `json:"conditioning,omitempty"`
+	Tags                 []string      `json:"tags,omitempty"`
+	HeroImage            *string       `json:"heroImage,omitempty"`
+	Private              bool          `json:"private"`
+	Comment              *string       `json:"comment,omitempty"`
+	PositiveTriggerWords *string       `json:"positiveTriggerWords,omitempty"`
+	DefaultWidth         *int          `json:"defaultWidth,omitempty"`
+	DefaultHeight        *int          `json:"defaultHeight,omitempty"`
+	DefaultSteps         *int          `json:"defaultSteps,omitempty"`
+	DefaultScheduler     *string       `json:"defaultScheduler,omitempty"`
+	DefaultCFG           *float64      `json:"defaultCFG,omitempty"`
+	DefaultStrength      *float64      `json:"defaultStrength,omitempty"`
+}
+
+// ModelUploadStatus reports the progress of a model upload
+type ModelUploadStatus string
+
+const (
+	ModelUploadStatusValidated   ModelUploadStatus = "validated"
+	ModelUploadStatusDownloading ModelUploadStatus = "downloading"
+	ModelUploadStatusDownloaded  ModelUploadStatus = "downloaded"
+	ModelUploadStatusStoring     ModelUploadStatus = "storing"
+	ModelUploadStatusStored      ModelUploadStatus = "stored"
+	ModelUploadStatusOptimizing  ModelUploadStatus = "optimizing"
+	ModelUploadStatusOptimized   ModelUploadStatus = "optimized"
+	ModelUploadStatusReady       ModelUploadStatus = "ready"
+)
+
+// ModelFormat is the file format of an uploaded model
+type ModelFormat string
+
+const (
+	ModelFormatSafetensors  ModelFormat = "safetensors"
+	ModelFormatPickleTensor ModelFormat = "pickletensor"
+)
+
+// ModelUploadRequest uploads a custom model. Use the constructor for the model's
+// category (NewCheckpointUploadRequest, NewLoRAUploadRequest, NewControlNetUploadRequest,
+// NewEmbeddingUploadRequest); category-specific fields are only sent when set.
+type ModelUploadRequest struct {
+	TaskType         string        `json:"taskType"`
+	TaskUUID         string        `json:"taskUUID"`
+	Category         ModelCategory `json:"category"`
+	AIR              string        `json:"air"`
+	Name             string        `json:"name"`
+	Version          string        `json:"version"`
+	DownloadURL      string        `json:"downloadURL"`
+	UniqueIdentifier string        `json:"uniqueIdentifier"`
+	Architecture     string        `json:"architecture"`
+	Format           ModelFormat   `json:"format"`
+	Private          bool          `json:"private"`
+	HeroImageURL     *string       `json:"heroImageURL,omitempty"`
+	Tags             []string      `json:"tags,omitempty"`
+	ShortDescription *string       `json:"shortDescription,omitempty"`
+	Comment          *string       `json:"comment,omitempty"`
+	// Checkpoint fields
+	Type             *ModelType `json:"type,omitempty"`
+	DefaultWidth     *int       `json:"defaultWidth,omitempty"`
+	DefaultHeight    *int       `json:"defaultHeight,omitempty"`
+	DefaultSteps     *int       `json:"defaultSteps,omitempty"`
+	DefaultScheduler *string    `json:"defaultScheduler,omitempty"`
+	DefaultCFG       *float64   `json:"defaultCFG,omitempty"`
+	DefaultStrength  *float64   `json:"defaultStrength,omitempty"`
+	// LoRA and embedding fields
+	DefaultWeight        *float64 `json:"defaultWeight,omitempty"`
+	PositiveTriggerWords *string  `json:"positiveTriggerWords,omitempty"`
+	// ControlNet fields
+	Conditioning *string `json:"conditioning,omitempty"`
+}
+
+type ModelUploadResponse struct {
+	TaskType string            `json:"taskType"`
+	TaskUUID string            `json:"taskUUID"`
+	AIR      string            `json:"air,omitempty"`
+	Status   ModelUploadStatus `json:"status,omitempty"`
+	Message  *string           `json:"message,omitempty"`
+}
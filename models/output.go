@@ -0,0 +1,285 @@
+package models
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrNoOutput is returned by Open and Save when a response carries no URL or
+	// base64 content, e.g. an async acknowledgment that has not finished yet.
+	ErrNoOutput = errors.New("response has no output content")
+
+	// ErrUnexpectedContentType is returned by Open and Save when the content is not
+	// the expected kind of media, e.g. an HTML error page instead of an image.
+	ErrUnexpectedContentType = errors.New("unexpected content type")
+)
+
+// DefaultDownloadTimeout bounds a download made by Open, Save or Decode unless
+// WithHTTPClient supplies a client of its own
+const DefaultDownloadTimeout = 5 * time.Minute
+
+var defaultDownloadClient = &http.Client{Timeout: DefaultDownloadTimeout}
+
+// Downloadable is implemented by responses that carry generated media. Pass one to
+// Open or Save to read its output.
+type Downloadable interface {
+	output() mediaOutput
+}
+
+// DownloadOption configures how Open, Save and Decode fetch URL outputs
+type DownloadOption func(*downloadOptions)
+
+type downloadOptions struct {
+	httpClient *http.Client
+}
+
+// WithHTTPClient downloads URL outputs with client instead of the default client,
+// which gives up after DefaultDownloadTimeout
+func WithHTTPClient(client *http.Client) DownloadOption {
+	return func(o *downloadOptions) { o.httpClient = client }
+}
+
+func newDownloadOptions(opts []DownloadOption) *downloadOptions {
+	o := &downloadOptions{httpClient: defaultDownloadClient}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.httpClient == nil {
+		o.httpClient = defaultDownloadClient
+	}
+	return o
+}
+
+// Open returns the output content of r, downloading it if the response holds a URL
+func Open(ctx context.Context, r Downloadable, opts ...DownloadOption) (io.ReadCloser, error) {
+	body, _, err := r.output().open(ctx, newDownloadOptions(opts))
+	return body, err
+}
+
+// Save writes the output of r to dir, named by its UUID, and returns the file path
+func Save(ctx context.Context, r Downloadable, dir string, opts ...DownloadOption) (string, error) {
+	return r.output().save(ctx, dir, newDownloadOptions(opts))
+}
+
+// mediaOutput describes where a response's content can be read from
+type mediaOutput struct {
+	kind       string // "image", "video" or "audio"
+	uuid       string
+	url        *string
+	base64Data *string
+	dataURI    *string
+	svg        bool // vectorized output, whose SVG content sniffs as XML or plain text
+}
+
+// open returns the content along with its verified content type
+func (o mediaOutput) open(ctx context.Context, opts *downloadOptions) (io.ReadCloser, string, error) {
+	var body io.ReadCloser
+	var declared string
+
+	switch {
+	case o.dataURI != nil && *o.dataURI != "":
+		header, data, ok := strings.Cut(*o.dataURI, ",")
+		if !ok || !strings.HasPrefix(header, "data:") || !strings.HasSuffix(header, ";base64") {
+			return nil, "", fmt.Errorf("malformed data URI")
+		}
+		declared = strings.TrimSuffix(strings.TrimPrefix(header, "data:"), ";base64")
+		body = io.NopCloser(base64.NewDecoder(base64.StdEncoding, strings.NewReader(data)))
+	case o.base64Data != nil && *o.base64Data != "":
+		body = io.NopCloser(base64.NewDecoder(base64.StdEncoding, strings.NewReader(*o.base64Data)))
+	case o.url != nil && *o.url != "":
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, *o.url, nil)
+		if err != nil {
+			return nil, "", err
+		}
+		resp, err := opts.httpClient.Do(req)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to download %s: %w", o.kind, err)
+		}
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			resp.Body.Close()
+			return nil, "", fmt.Errorf("failed to download %s: HTTP %d", o.kind, resp.StatusCode)
+		}
+		declared = resp.Header.Get("Content-Type")
+		body = resp.Body
+	default:
+		return nil, "", ErrNoOutput
+	}
+
+	// Prefer the declared type, falling back to sniffing when it is missing or generic
+	br := bufio.NewReaderSize(body, 512)
+	contentType, _, _ := mime.ParseMediaType(declared)
+	if contentType == "" || contentType == "application/octet-stream" {
+		head, _ := br.Peek(512)
+		contentType, _, _ = mime.ParseMediaType(http.DetectContentType(head))
+	}
+	if !o.accepts(contentType) {
+		body.Close()
+		return nil, "", fmt.Errorf("%w: got %s, want %s", ErrUnexpectedContentType, contentType, o.kind)
+	}
+	return readCloser{Reader: br, Closer: body}, contentType, nil
+}
+
+// accepts reports whether contentType is plausible for the output kind
+func (o mediaOutput) accepts(contentType string) bool {
+	switch {
+	case strings.HasPrefix(contentType, o.kind+"/"):
+		return true
+	case contentType == "application/octet-stream":
+		// Formats the sniffer does not know (e.g. FLAC, MOV) are still accepted
+		return true
+	case o.kind == "audio" && contentType == "application/ogg":
+		return true
+	case o.svg && isSniffedSVG(contentType):
+		return true
+	}
+	return false
+}
+
+// isSniffedSVG reports whether contentType is what SVG content sniffs as
+func isSniffedSVG(contentType string) bool {
+	return contentType == "text/xml" || contentType == "text/plain"
+}
+
+// save streams the content to a temporary file in dir and renames it into place,
+// so a partially written file is never left under the final name
+func (o mediaOutput) save(ctx context.Context, dir string, opts *downloadOptions) (string, error) {
+	body, contentType, err := o.open(ctx, opts)
+	if err != nil {
+		return "", err
+	}
+	defer body.Close()
+
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return "", fmt.Errorf("failed to create directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, ".runware-*.tmp")
+	if err != nil {
+		return "", fmt.Errorf("failed to create file: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // no-op once renamed
+
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("failed to write %s: %w", o.kind, err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		return "", err
+	}
+
+	dest := filepath.Join(dir, o.fileName(contentType))
+	if err := os.Rename(tmp.Name(), dest); err != nil {
+		return "", fmt.Errorf("failed to save %s: %w", o.kind, err)
+	}
+	return dest, nil
+}
+
+// fileName returns the output UUID with an extension for the output format. The
+// extension in the URL reflects the requested OutputFormat, so it is preferred
+// over one derived from the content type.
+func (o mediaOutput) fileName(contentType string) string {
+	ext := ""
+	if o.url != nil {
+		if u, err := url.Parse(*o.url); err == nil {
+			ext = strings.ToLower(path.Ext(u.Path))
+		}
+	}
+	if ext == "" && o.svg && isSniffedSVG(contentType) {
+		ext = ".svg"
+	}
+	if ext == "" {
+		ext = extensionForContentType[contentType]
+	}
+	if ext == "" {
+		ext = ".bin"
+	}
+
+	name := o.uuid
+	if name == "" {
+		name = o.kind
+	}
+	return filepath.Base(name) + ext
+}
+
+var extensionForContentType = map[string]string{
+	"image/png":       ".png",
+	"image/jpeg":      ".jpg",
+	"image/webp":      ".webp",
+	"image/gif":       ".gif",
+	"image/svg+xml":   ".svg",
+	"video/mp4":       ".mp4",
+	"video/webm":      ".webm",
+	"video/quicktime": ".mov",
+	"audio/mpeg":      ".mp3",
+	"audio/wav":       ".wav",
+	"audio/wave":      ".wav",
+	"audio/x-wav":     ".wav",
+	"audio/flac":      ".flac",
+	"audio/ogg":       ".ogg",
+	"application/ogg": ".ogg",
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func (r *ImageInferenceResponse) output() mediaOutput {
+	return mediaOutput{kind: "image", uuid: r.ImageUUID, url: r.ImageURL, base64Data: r.ImageBase64Data, dataURI: r.ImageDataURI}
+}
+
+func (r *UpscaleGanResponse) output() mediaOutput {
+	return mediaOutput{kind: "image", uuid: r.ImageUUID, url: r.ImageURL, base64Data: r.ImageBase64Data, dataURI: r.ImageDataURI}
+}
+
+func (r *RemoveImageBackgroundResponse) output() mediaOutput {
+	return mediaOutput{kind: "image", uuid: r.ImageUUID, url: r.ImageURL, base64Data: r.ImageBase64Data, dataURI: r.ImageDataURI}
+}
+
+func (r *ControlNetPreprocessResponse) output() mediaOutput {
+	return mediaOutput{kind: "image", uuid: r.GuideImageUUID, url: r.GuideImageURL, base64Data: r.GuideImageBase64Data, dataURI: r.GuideImageDataURI}
+}
+
+func (r *ImageMaskingResponse) output() mediaOutput {
+	return mediaOutput{kind: "image", uuid: r.MaskImageUUID, url: r.MaskImageURL, base64Data: r.MaskImageBase64Data, dataURI: r.MaskImageDataURI}
+}
+
+func (r *PhotoMakerResponse) output() mediaOutput {
+	return mediaOutput{kind: "image", uuid: r.ImageUUID, url: r.ImageURL, base64Data: r.ImageBase64Data, dataURI: r.ImageDataURI}
+}
+
+func (r *VectorizeResponse) output() mediaOutput {
+	return mediaOutput{kind: "image", uuid: r.ImageUUID, url: r.ImageURL, base64Data: r.ImageBase64Data, dataURI: r.ImageDataURI, svg: true}
+}
+
+func (r *VideoInferenceResponse) output() mediaOutput {
+	return mediaOutput{kind: "video", uuid: r.VideoUUID, url: r.VideoURL}
+}
+
+func (r *VideoUpscaleResponse) output() mediaOutput {
+	return mediaOutput{kind: "video", uuid: r.VideoUUID, url: r.VideoURL}
+}
+
+func (r *VideoBackgroundRemovalResponse) output() mediaOutput {
+	return mediaOutput{kind: "video", uuid: r.VideoUUID, url: r.VideoURL}
+}
+
+func (r *AudioInferenceResponse) output() mediaOutput {
+	return mediaOutput{kind: "audio", uuid: r.AudioUUID, url: r.AudioURL, base64Data: r.AudioBase64Data, dataURI: r.AudioDataURI}
+}
@@ -0,0 +1,155 @@
+package models
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// pngHeader is enough of a PNG for content sniffing
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func strPtr(s string) *string { return &s }
+
+func TestSaveFromURLUsesURLExtension(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/webp")
+		_, _ = w.Write([]byte("RIFF\x00\x00\x00\x00WEBPVP8 "))
+	}))
+	defer server.Close()
+
+	resp := &ImageInferenceResponse{ImageUUID: "img-1", ImageURL: strPtr(server.URL + "/img-1.webp")}
+	dir := t.TempDir()
+	path, err := Save(context.Background(), resp, dir)
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if want := filepath.Join(dir, "img-1.webp"); path != want {
+		t.Errorf("Save() path = %q, want %q", path, want)
+	}
+
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 {
+		t.Errorf("dir has %d entries, want only the saved file", len(entries))
+	}
+}
+
+func TestSaveFromBase64SniffsExtension(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString(pngHeader)
+	resp := &UpscaleGanResponse{ImageUUID: "img-2", ImageBase64Data: &encoded}
+
+	path, err := Save(context.Background(), resp, t.TempDir())
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if filepath.Base(path) != "img-2.png" {
+		t.Errorf("Save() file = %q, want img-2.png", filepath.Base(path))
+	}
+	data, _ := os.ReadFile(path)
+	if string(data) != string(pngHeader) {
+		t.Error("saved content does not match the decoded base64 data")
+	}
+}
+
+func TestSaveVectorizedSVG(t *testing.T) {
+	svg := base64.StdEncoding.EncodeToString([]byte(`<svg xmlns="http://www.w3.org/2000/svg"></svg>`))
+
+	vector := &VectorizeResponse{ImageUUID: "vec-1", ImageBase64Data: &svg}
+	path, err := Save(context.Background(), vector, t.TempDir())
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if filepath.Base(path) != "vec-1.svg" {
+		t.Errorf("Save() file = %q, want vec-1.svg", filepath.Base(path))
+	}
+
+	// Only vectorize responses are expected to carry text content
+	image := &ImageInferenceResponse{ImageUUID: "img-4", ImageBase64Data: &svg}
+	if _, err := Open(context.Background(), image); !errors.Is(err, ErrUnexpectedContentType) {
+		t.Errorf("Open() error = %v, want ErrUnexpectedContentType", err)
+	}
+}
+
+func TestOpenDataURI(t *testing.T) {
+	dataURI := "data:audio/mpeg;base64," + base64.StdEncoding.EncodeToString([]byte("ID3 audio"))
+	resp := &AudioInferenceResponse{AudioUUID: "aud-1", AudioDataURI: &dataURI}
+
+	body, err := Open(context.Background(), resp)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer body.Close()
+	data, _ := io.ReadAll(body)
+	if string(data) != "ID3 audio" {
+		t.Errorf("Open() content = %q, want %q", data, "ID3 audio")
+	}
+
+	path, err := Save(context.Background(), resp, t.TempDir())
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if filepath.Base(path) != "aud-1.mp3" {
+		t.Errorf("Save() file = %q, want aud-1.mp3", filepath.Base(path))
+	}
+}
+
+func TestOpenRejectsUnexpectedContentType(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte("<html>not found</html>"))
+	}))
+	defer server.Close()
+
+	resp := &VideoInferenceResponse{VideoUUID: "vid-1", VideoURL: strPtr(server.URL + "/vid-1.mp4")}
+	dir := t.TempDir()
+	if _, err := Save(context.Background(), resp, dir); !errors.Is(err, ErrUnexpectedContentType) {
+		t.Errorf("Save() error = %v, want ErrUnexpectedContentType", err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("dir has %d entries, want none after a failed save", len(entries))
+	}
+}
+
+func TestOpenHTTPError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	resp := &VideoUpscaleResponse{VideoUUID: "vid-2", VideoURL: strPtr(server.URL)}
+	if _, err := Open(context.Background(), resp); err == nil {
+		t.Error("Open() should fail on HTTP 404")
+	}
+}
+
+func TestOpenNoOutput(t *testing.T) {
+	resp := &VideoInferenceResponse{TaskUUID: "task", Status: TaskStatusProcessing}
+	if _, err := Open(context.Background(), resp); !errors.Is(err, ErrNoOutput) {
+		t.Errorf("Open() error = %v, want ErrNoOutput", err)
+	}
+}
+
+func TestOpenWithHTTPClient(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := &http.Client{Timeout: 50 * time.Millisecond}
+	resp := &VideoInferenceResponse{VideoUUID: "vid-3", VideoURL: strPtr(server.URL)}
+	_, err := Open(context.Background(), resp, WithHTTPClient(client))
+	var netErr net.Error
+	if !errors.As(err, &netErr) || !netErr.Timeout() {
+		t.Errorf("Open() error = %v, want the client timeout", err)
+	}
+}
@@ -1,5 +1,7 @@
 package models
 
+import "encoding/json"
+
 // Shared enums and basic types used by multiple domains
 
 // OutputType specifies the format of the output image/audio/video reference
@@ -18,6 +20,8 @@ const (
 	OutputFormatJPG  OutputFormat = "jpg"
 	OutputFormatPNG  OutputFormat = "png"
 	OutputFormatWEBP OutputFormat = "webp"
+	// OutputFormatSVG is only supported by vectorization
+	OutputFormatSVG OutputFormat = "svg"
 )
 
 // DeliveryMethod specifies how the result should be delivered
@@ -65,7 +69,17 @@ const (
 	TaskTypeImageUpload            = "imageUpload"
 	TaskTypeUpscaleGan             = "imageUpscale"
 	TaskTypeImageBackgroundRemoval = "imageBackgroundRemoval"
+	TaskTypeControlNetPreprocess   = "imageControlNetPreProcess"
+	TaskTypeImageMasking           = "imageMasking"
+	TaskTypePhotoMaker             = "photoMaker"
+	TaskTypeVectorize              = "vectorize"
+	TaskTypeAccountManagement      = "accountManagement"
+	TaskTypeVideoUpscale           = "videoUpscale"
+	TaskTypeVideoBackgroundRemoval = "videoBackgroundRemoval"
+	TaskTypeMediaStorage           = "mediaStorage"
 	TaskTypeGetResponse            = "getResponse"
+	TaskTypeModelSearch            = "modelSearch"
+	TaskTypeModelUpload            = "modelUpload"
 )
 
 // Acceleration specifies acceleration mode
@@ -265,15 +279,92 @@ const (
 
 // Generic API envelope
 type ErrorResponse struct {
-	Error    string `json:"error"`
-	ErrorID  string `json:"errorId,omitempty"`
-	Code     string `json:"code,omitempty"`
-	Message  string `json:"message,omitempty"`
-	TaskUUID string `json:"taskUUID,omitempty"`
-	TaskType string `json:"taskType,omitempty"`
+	Error         string `json:"error,omitempty"`
+	ErrorID       string `json:"errorId,omitempty"`
+	Code          string `json:"code,omitempty"`
+	Message       string `json:"message,omitempty"`
+	Parameter     string `json:"parameter,omitempty"`
+	Type          string `json:"type,omitempty"`
+	Documentation string `json:"documentation,omitempty"`
+	TaskUUID      string `json:"taskUUID,omitempty"`
+	TaskType      string `json:"taskType,omitempty"`
 }
 
 type APIResponse struct {
 	Data  []interface{}  `json:"data,omitempty"`
 	Error *ErrorResponse `json:"error,omitempty"`
 }
+
+// GetResponseResult holds every result returned by a getResponse request, typed
+// according to the taskType of the original task. Only the slice matching
+// TaskType is populated.
+type GetResponseResult struct {
+	TaskType                string
+	TaskUUID                string
+	Images                  []*ImageInferenceResponse
+	Videos                  []*VideoInferenceResponse
+	Audio                   []*AudioInferenceResponse
+	Upscales                []*UpscaleGanResponse
+	BackgroundRemovals      []*RemoveImageBackgroundResponse
+	VideoUpscales           []*VideoUpscaleResponse
+	VideoBackgroundRemovals []*VideoBackgroundRemovalResponse
+	// Unknown holds raw items whose taskType is not supported by this SDK version
+	Unknown []json.RawMessage
+}
+
+// Add appends a typed response to the matching slice, reporting false if the
+// response type is not a supported getResponse result.
+func (r *GetResponseResult) Add(resp interface{}) bool {
+	switch v := resp.(type) {
+	case *ImageInferenceResponse:
+		r.Images = append(r.Images, v)
+	case *VideoInferenceResponse:
+		r.Videos = append(r.Videos, v)
+	case *AudioInferenceResponse:
+		r.Audio = append(r.Audio, v)
+	case *UpscaleGanResponse:
+		r.Upscales = append(r.Upscales, v)
+	case *RemoveImageBackgroundResponse:
+		r.BackgroundRemovals = append(r.BackgroundRemovals, v)
+	case *VideoUpscaleResponse:
+		r.VideoUpscales = append(r.VideoUpscales, v)
+	case *VideoBackgroundRemovalResponse:
+		r.VideoBackgroundRemovals = append(r.VideoBackgroundRemovals, v)
+	default:
+		return false
+	}
+	return true
+}
+
+// All returns every typed result in delivery order within each type
+func (r *GetResponseResult) All() []interface{} {
+	all := make([]interface{}, 0, r.Len())
+	for _, v := range r.Images {
+		all = append(all, v)
+	}
+	for _, v := range r.Videos {
+		all = append(all, v)
+	}
+	for _, v := range r.Audio {
+		all = append(all, v)
+	}
+	for _, v := range r.Upscales {
+		all = append(all, v)
+	}
+	for _, v := range r.BackgroundRemovals {
+		all = append(all, v)
+	}
+	for _, v := range r.VideoUpscales {
+		all = append(all, v)
+	}
+	for _, v := range r.VideoBackgroundRemovals {
+		all = append(all, v)
+	}
+	return all
+}
+
+// Len returns the number of typed results
+func (r *GetResponseResult) Len() int {
+	return len(r.Images) + len(r.Videos) + len(r.Audio) + len(r.Upscales) + len(r.BackgroundRemovals) +
+		len(r.VideoUpscales) + len(r.VideoBackgroundRemovals)
+}
@@ -0,0 +1,360 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Validator is implemented by every request type. Validate checks a request against
+// the API's field constraints so that invalid requests fail before being sent.
+type Validator interface{ Validate() error }
+
+// FieldError describes a single invalid field
+type FieldError struct {
+	// Field is the JSON name of the field (e.g. "width", "outpaint.top")
+	Field string
+	// Message describes the constraint that was violated
+	Message string
+}
+
+// Error implements the error interface
+func (e FieldError) Error() string { return e.Field + ": " + e.Message }
+
+// ValidationError is returned by Validate and lists every invalid field in a request
+type ValidationError struct {
+	TaskType string
+	Fields   []FieldError
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		msgs[i] = f.Error()
+	}
+	return fmt.Sprintf("invalid %s request: %s", e.TaskType, strings.Join(msgs, "; "))
+}
+
+// Has reports whether the named field failed validation
+func (e *ValidationError) Has(field string) bool {
+	for _, f := range e.Fields {
+		if f.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+// Limits enforced by Validate
+const (
+	MinImageDimension   = 128
+	MaxImageDimension   = 2048
+	ImageDimensionStep  = 64
+	MaxNumberResults    = 20
+	MinOutputQuality    = 20
+	MaxOutputQuality    = 99
+	MaxPhotoMakerImages = 4
+)
+
+// SupportedVideoDurations lists the durations (in seconds) accepted by video models
+// with a fixed set of durations. Validate only consults it for models without
+// registered capabilities.
+//
+// Deprecated: Register the model's durations with RegisterVideoCapabilities instead.
+var SupportedVideoDurations = map[string][]int{
+	"klingai:5@3": {5, 10},
+	"openai:3@1":  {4, 8, 12},
+}
+
+// validator accumulates field errors for a request
+type validator struct {
+	taskType string
+	fields   []FieldError
+}
+
+func (v *validator) add(field, format string, args ...interface{}) {
+	v.fields = append(v.fields, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) required(field, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.add(field, "is required")
+	}
+}
+
+func (v *validator) intRange(field string, value *int, lo, hi int) {
+	if value != nil && (*value < lo || *value > hi) {
+		v.add(field, "must be between %d and %d, got %d", lo, hi, *value)
+	}
+}
+
+func (v *validator) floatRange(field string, value *float64, lo, hi float64) {
+	if value != nil && (*value < lo || *value > hi) {
+		v.add(field, "must be between %g and %g, got %g", lo, hi, *value)
+	}
+}
+
+func (v *validator) imageDimension(field string, value int) {
+	switch {
+	case value < MinImageDimension || value > MaxImageDimension:
+		v.add(field, "must be between %d and %d, got %d", MinImageDimension, MaxImageDimension, value)
+	case value%ImageDimensionStep != 0:
+		v.add(field, "must be a multiple of %d, got %d", ImageDimensionStep, value)
+	}
+}
+
+func (v *validator) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return &ValidationError{TaskType: v.taskType, Fields: v.fields}
+}
+
+// Validate checks the request against the API's field constraints
+func (r *ImageInferenceRequest) Validate() error {
+	v := &validator{taskType: TaskTypeImageInference}
+	v.required("positivePrompt", r.PositivePrompt)
+	v.required("model", r.Model)
+	v.imageDimension("width", r.Width)
+	v.imageDimension("height", r.Height)
+	v.floatRange("strength", r.Strength, 0, 1)
+	v.intRange("numberResults", r.NumberResults, 1, MaxNumberResults)
+	v.intRange("steps", r.Steps, 1, 100)
+	v.floatRange("CFGScale", r.CFGScale, 0, 50)
+	v.intRange("clipSkip", r.ClipSkip, 0, 2)
+	v.intRange("outputQuality", r.OutputQuality, MinOutputQuality, MaxOutputQuality)
+	if r.SeedImage == nil || *r.SeedImage == "" {
+		if r.Outpaint != nil {
+			v.add("outpaint", "requires seedImage")
+		}
+		if r.MaskImage != nil {
+			v.add("maskImage", "requires seedImage")
+		}
+	}
+	return v.err()
+}
+
+// Validate checks the request against the API's field constraints
+func (r *UploadImageRequest) Validate() error {
+	v := &validator{taskType: TaskTypeImageUpload}
+	set := 0
+	for _, s := range []*string{r.ImageBase64, r.ImageDataURI, r.ImageURL} {
+		if s != nil && *s != "" {
+			set++
+		}
+	}
+	if set != 1 {
+		v.add("image", "exactly one of imageBase64, imageDataURI or imageURL is required")
+	}
+	return v.err()
+}
+
+// Validate checks the request against the API's field constraints
+func (r *UpscaleGanRequest) Validate() error {
+	v := &validator{taskType: TaskTypeUpscaleGan}
+	v.required("inputImage", r.InputImage)
+	v.intRange("upscaleFactor", &r.UpscaleFactor, 2, 4)
+	v.intRange("outputQuality", r.OutputQuality, MinOutputQuality, MaxOutputQuality)
+	return v.err()
+}
+
+// Validate checks the request against the API's field constraints
+func (r *RemoveImageBackgroundRequest) Validate() error {
+	v := &validator{taskType: TaskTypeImageBackgroundRemoval}
+	v.required("inputImage", r.InputImage)
+	v.intRange("outputQuality", r.OutputQuality, MinOutputQuality, MaxOutputQuality)
+	if r.Rgba != nil && len(r.Rgba) != 4 {
+		v.add("rgba", "must have 4 values, got %d", len(r.Rgba))
+	}
+	return v.err()
+}
+
+// Validate checks the request against the API's field constraints
+func (r *EnhancePromptRequest) Validate() error {
+	v := &validator{taskType: TaskTypePromptEnhance}
+	v.required("prompt", r.Prompt)
+	v.intRange("promptMaxLength", r.PromptMaxLength, 12, 400)
+	v.intRange("promptVersions", r.PromptVersions, 1, 5)
+	return v.err()
+}
+
+// Validate checks the request against the API's field constraints
+func (r *ImageCaptionRequest) Validate() error {
+	v := &validator{taskType: TaskTypeImageCaption}
+	v.required("inputImage", r.InputImage)
+	return v.err()
+}
+
+// Validate checks the request against the API's field constraints
+func (r *ControlNetPreprocessRequest) Validate() error {
+	v := &validator{taskType: TaskTypeControlNetPreprocess}
+	v.required("inputImage", r.InputImage)
+	v.required("preProcessorType", string(r.PreProcessorType))
+	v.intRange("lowThresholdCanny", r.LowThresholdCanny, 0, 255)
+	v.intRange("highThresholdCanny", r.HighThresholdCanny, 0, 255)
+	if r.LowThresholdCanny != nil && r.HighThresholdCanny != nil && *r.LowThresholdCanny > *r.HighThresholdCanny {
+		v.add("lowThresholdCanny", "must not exceed highThresholdCanny")
+	}
+	return v.err()
+}
+
+// Validate checks the request against the API's field constraints
+func (r *ImageMaskingRequest) Validate() error {
+	v := &validator{taskType: TaskTypeImageMasking}
+	v.required("inputImage", r.InputImage)
+	v.required("model", r.Model)
+	v.floatRange("confidence", r.Confidence, 0, 1)
+	v.intRange("maxDetections", r.MaxDetections, 1, 20)
+	v.intRange("outputQuality", r.OutputQuality, MinOutputQuality, MaxOutputQuality)
+	return v.err()
+}
+
+// Validate checks the request against the API's field constraints
+func (r *PhotoMakerRequest) Validate() error {
+	v := &validator{taskType: TaskTypePhotoMaker}
+	if n := len(r.InputImages); n < 1 || n > MaxPhotoMakerImages {
+		v.add("inputImages", "must have between 1 and %d images, got %d", MaxPhotoMakerImages, n)
+	}
+	v.required("positivePrompt", r.PositivePrompt)
+	if r.PositivePrompt != "" && !strings.Contains(r.PositivePrompt, PhotoMakerTriggerWord) {
+		v.add("positivePrompt", "must contain the trigger word %q", PhotoMakerTriggerWord)
+	}
+	v.required("model", r.Model)
+	v.imageDimension("width", r.Width)
+	v.imageDimension("height", r.Height)
+	v.intRange("strength", r.Strength, 15, 50)
+	v.intRange("numberResults", r.NumberResults, 1, MaxNumberResults)
+	v.intRange("steps", r.Steps, 1, 100)
+	v.floatRange("CFGScale", r.CFGScale, 0, 50)
+	v.intRange("outputQuality", r.OutputQuality, MinOutputQuality, MaxOutputQuality)
+	return v.err()
+}
+
+// Validate checks the request against the API's field constraints
+func (r *VectorizeRequest) Validate() error {
+	v := &validator{taskType: TaskTypeVectorize}
+	v.required("model", r.Model)
+	v.required("inputs.image", r.Inputs.Image)
+	return v.err()
+}
+
+// Validate checks the request against the API's field constraints, including
+// the capabilities registered for the model (see LookupVideoCapabilities)
+func (r *VideoInferenceRequest) Validate() error {
+	v := &validator{taskType: TaskTypeVideoInference}
+	v.required("model", r.Model)
+	if strings.TrimSpace(r.PositivePrompt) == "" && len(r.FrameImages) == 0 {
+		v.add("positivePrompt", "is required unless frameImages are provided")
+	}
+	for _, dim := range []struct {
+		field string
+		value *int
+	}{{"width", r.Width}, {"height", r.Height}} {
+		if dim.value != nil && *dim.value <= 0 {
+			v.add(dim.field, "must be positive, got %d", *dim.value)
+		}
+	}
+	if r.Duration != nil && *r.Duration <= 0 {
+		v.add("duration", "must be positive, got %d", *r.Duration)
+	}
+	v.intRange("fps", r.FPS, 15, 60)
+	v.intRange("numberResults", r.NumberResults, 1, MaxNumberResults)
+	v.floatRange("CFGScale", r.CFGScale, 0, 50)
+	v.intRange("outputQuality", r.OutputQuality, MinOutputQuality, MaxOutputQuality)
+	if len(r.FrameImages) > 2 {
+		v.add("frameImages", "must have at most 2 images, got %d", len(r.FrameImages))
+	}
+	seen := map[FramePosition]bool{}
+	for _, f := range r.FrameImages {
+		if f.Frame != "" && seen[f.Frame] {
+			v.add("frameImages", "has more than one %s frame", f.Frame)
+		}
+		seen[f.Frame] = true
+	}
+	if caps, ok := LookupVideoCapabilities(r.Model); ok {
+		caps.check(v, r)
+	} else if durations, ok := SupportedVideoDurations[r.Model]; ok && r.Duration != nil && !slices.Contains(durations, *r.Duration) {
+		v.add("duration", "must be one of %v for %s, got %d", durations, r.Model, *r.Duration)
+	}
+	return v.err()
+}
+
+// Validate checks the request against the API's field constraints
+func (r *VideoUpscaleRequest) Validate() error {
+	v := &validator{taskType: TaskTypeVideoUpscale}
+	v.required("inputVideo", r.InputVideo)
+	v.intRange("upscaleFactor", &r.UpscaleFactor, 2, 4)
+	v.intRange("outputQuality", r.OutputQuality, MinOutputQuality, MaxOutputQuality)
+	return v.err()
+}
+
+// Validate checks the request against the API's field constraints
+func (r *VideoBackgroundRemovalRequest) Validate() error {
+	v := &validator{taskType: TaskTypeVideoBackgroundRemoval}
+	v.required("inputVideo", r.InputVideo)
+	v.intRange("outputQuality", r.OutputQuality, MinOutputQuality, MaxOutputQuality)
+	if r.Rgba != nil && len(r.Rgba) != 4 {
+		v.add("rgba", "must have 4 values, got %d", len(r.Rgba))
+	}
+	return v.err()
+}
+
+// Validate checks the request against the API's field constraints
+func (r *AudioInferenceRequest) Validate() error {
+	v := &validator{taskType: TaskTypeAudioInference}
+	v.required("positivePrompt", r.PositivePrompt)
+	v.required("model", r.Model)
+	if r.Duration != nil && *r.Duration <= 0 {
+		v.add("duration", "must be positive, got %d", *r.Duration)
+	}
+	v.intRange("numberResults", r.NumberResults, 1, MaxNumberResults)
+	return v.err()
+}
+
+// Validate checks the request against the API's field constraints
+func (r *MediaUploadRequest) Validate() error {
+	v := &validator{taskType: TaskTypeMediaStorage}
+	v.required("operation", string(r.Operation))
+	v.required("media", r.Media)
+	return v.err()
+}
+
+// Validate checks the request against the API's field constraints
+func (r *ModelSearchRequest) Validate() error {
+	v := &validator{taskType: TaskTypeModelSearch}
+	v.intRange("limit", r.Limit, 1, ModelSearchMaxLimit)
+	if r.Offset != nil && *r.Offset < 0 {
+		v.add("offset", "must not be negative, got %d", *r.Offset)
+	}
+	return v.err()
+}
+
+// Validate checks the request against the API's field constraints
+func (r *ModelUploadRequest) Validate() error {
+	v := &validator{taskType: TaskTypeModelUpload}
+	v.required("category", string(r.Category))
+	v.required("air", r.AIR)
+	v.required("name", r.Name)
+	v.required("version", r.Version)
+	v.required("downloadURL", r.DownloadURL)
+	v.required("architecture", r.Architecture)
+	if r.Category == ModelCategoryControlNet && (r.Conditioning == nil || *r.Conditioning == "") {
+		v.add("conditioning", "is required for ControlNet models")
+	}
+	return v.err()
+}
+
+// Validate checks the request against the API's field constraints
+func (r *AccountManagementRequest) Validate() error {
+	v := &validator{taskType: TaskTypeAccountManagement}
+	v.required("operation", string(r.Operation))
+	return v.err()
+}
+
+// Validate checks the request against the API's field constraints
+func (r *GetResponseRequest) Validate() error {
+	v := &validator{taskType: TaskTypeGetResponse}
+	v.required("taskUUID", r.TaskUUID)
+	return v.err()
+}
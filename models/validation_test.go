@@ -0,0 +1,94 @@
+package models
+
+import (
+	"errors"
+	"testing"
+)
+
+func intPtr(i int) *int { return &i }
+
+func TestImageInferenceRequestValidate(t *testing.T) {
+	strength := 1.5
+	req := NewImageInferenceRequest("a castle", "runware:101@1", 1000, 1024)
+	req.Strength = &strength
+	req.NumberResults = intPtr(25)
+	req.Outpaint = &Outpaint{}
+
+	err := req.Validate()
+	var vErr *ValidationError
+	if !errors.As(err, &vErr) {
+		t.Fatalf("Validate() error = %v, want *ValidationError", err)
+	}
+	for _, field := range []string{"width", "strength", "numberResults", "outpaint"} {
+		if !vErr.Has(field) {
+			t.Errorf("Validate() should report %q, got %v", field, vErr)
+		}
+	}
+	if vErr.Has("height") {
+		t.Errorf("Validate() should not report height, got %v", vErr)
+	}
+	if len(vErr.Fields) != 4 {
+		t.Errorf("Validate() reported %d fields, want 4: %v", len(vErr.Fields), vErr)
+	}
+
+	if err := NewImageInferenceRequest("a castle", "runware:101@1", 1024, 576).Validate(); err != nil {
+		t.Errorf("Validate() on a valid request error = %v", err)
+	}
+}
+
+func TestVideoInferenceRequestValidateDuration(t *testing.T) {
+	tests := []struct {
+		model    string
+		duration int
+		wantErr  bool
+	}{
+		{"klingai:5@3", 5, false},
+		{"klingai:5@3", 7, true},
+		{"openai:3@1", 12, false},
+		{"openai:3@1", 5, true},
+		{"unknown:1@1", 7, false},
+		{"unknown:1@1", 0, true},
+	}
+	for _, tt := range tests {
+		req := NewVideoInferenceRequest("waves", tt.model)
+		req.Duration = intPtr(tt.duration)
+		err := req.Validate()
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Validate(%s, %ds) error = %v, wantErr %v", tt.model, tt.duration, err, tt.wantErr)
+		}
+	}
+}
+
+func TestRequestsValidateRequiredFields(t *testing.T) {
+	tests := []struct {
+		name  string
+		req   Validator
+		field string
+	}{
+		{"UploadImage", NewUploadImageRequest(), "image"},
+		{"UpscaleGan", &UpscaleGanRequest{UpscaleFactor: 2}, "inputImage"},
+		{"RemoveBackground", &RemoveImageBackgroundRequest{}, "inputImage"},
+		{"EnhancePrompt", &EnhancePromptRequest{}, "prompt"},
+		{"ImageCaption", &ImageCaptionRequest{}, "inputImage"},
+		{"ControlNetPreprocess", &ControlNetPreprocessRequest{InputImage: "img"}, "preProcessorType"},
+		{"ImageMasking", &ImageMaskingRequest{InputImage: "img"}, "model"},
+		{"PhotoMaker", NewPhotoMakerRequest([]string{"img"}, "a portrait", "civitai:139562@344487", 1024, 1024), "positivePrompt"},
+		{"Vectorize", NewVectorizeRequest(""), "inputs.image"},
+		{"VideoUpscale", NewVideoUpscaleRequest("video", 8), "upscaleFactor"},
+		{"VideoBackgroundRemoval", NewVideoBackgroundRemovalRequest(""), "inputVideo"},
+		{"AudioInference", NewAudioInferenceRequest("", "elevenlabs:1@1", 10), "positivePrompt"},
+		{"MediaUpload", NewMediaUploadRequest(""), "media"},
+		{"ModelSearch", &ModelSearchRequest{Limit: intPtr(ModelSearchMaxLimit + 1)}, "limit"},
+		{"ModelUpload", &ModelUploadRequest{Category: ModelCategoryControlNet}, "conditioning"},
+		{"AccountManagement", &AccountManagementRequest{}, "operation"},
+		{"GetResponse", NewGetResponseRequest(""), "taskUUID"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var vErr *ValidationError
+			if err := tt.req.Validate(); !errors.As(err, &vErr) || !vErr.Has(tt.field) {
+				t.Errorf("Validate() error = %v, want %q reported", err, tt.field)
+			}
+		})
+	}
+}
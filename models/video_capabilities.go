@@ -0,0 +1,177 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Resolution is a video frame size in pixels
+type Resolution struct {
+	Width  int
+	Height int
+}
+
+// String returns the resolution as WIDTHxHEIGHT
+func (r Resolution) String() string { return fmt.Sprintf("%dx%d", r.Width, r.Height) }
+
+// VideoCapabilities describes what a video model or provider supports.
+// Nil slices and zero values mean the setting is not constrained.
+type VideoCapabilities struct {
+	// ProviderSettings is the providerSettings key the model accepts (e.g. "google").
+	// Empty means the model takes no provider settings.
+	ProviderSettings string
+
+	// Resolutions lists the supported width and height combinations
+	Resolutions []Resolution
+	// Durations lists the supported durations in seconds
+	Durations []int
+	// MinFPS and MaxFPS bound the supported frame rate
+	MinFPS, MaxFPS int
+	// FramePositions lists the frame image positions the model accepts
+	FramePositions []FramePosition
+
+	// InputAudio reports whether the model accepts inputAudios
+	InputAudio bool
+	// ReferenceVideos reports whether the model accepts referenceVideos
+	ReferenceVideos bool
+
+	// DefaultResolution, DefaultDuration and DefaultFPS are applied by
+	// NewVideoInferenceRequest. Zero values leave the field to the API.
+	DefaultResolution Resolution
+	DefaultDuration   int
+	DefaultFPS        int
+}
+
+// legacyVideoDefaults are used by NewVideoInferenceRequest for models that are not registered
+var legacyVideoDefaults = VideoCapabilities{DefaultResolution: Resolution{1920, 1080}, DefaultFPS: 30}
+
+var (
+	videoCapabilitiesMu sync.RWMutex
+	videoCapabilities   = map[string]*VideoCapabilities{
+		// Providers
+		"klingai:":   {FramePositions: []FramePosition{FramePositionFirst, FramePositionLast}},
+		"google:":    {ProviderSettings: "google", FramePositions: []FramePosition{FramePositionFirst}},
+		"openai:":    {FramePositions: []FramePosition{FramePositionFirst}},
+		"bytedance:": {ProviderSettings: "bytedance", InputAudio: true, ReferenceVideos: true},
+		"minimax:":   {ProviderSettings: "minimax", FramePositions: []FramePosition{FramePositionFirst, FramePositionLast}},
+		"pixverse:":  {ProviderSettings: "pixverse", FramePositions: []FramePosition{FramePositionFirst, FramePositionLast}},
+		"vidu:":      {ProviderSettings: "vidu", FramePositions: []FramePosition{FramePositionFirst, FramePositionLast}},
+
+		// Models
+		"klingai:5@3": {
+			FramePositions:    []FramePosition{FramePositionFirst, FramePositionLast},
+			Resolutions:       []Resolution{{1920, 1080}, {1080, 1920}, {1080, 1080}},
+			Durations:         []int{5, 10},
+			DefaultResolution: Resolution{1920, 1080},
+			DefaultDuration:   5,
+		},
+		"openai:3@1": {
+			FramePositions:    []FramePosition{FramePositionFirst},
+			Resolutions:       []Resolution{{1280, 720}, {720, 1280}},
+			Durations:         []int{4, 8, 12},
+			DefaultResolution: Resolution{1280, 720},
+			DefaultDuration:   4,
+		},
+		"google:3@": {
+			ProviderSettings:  "google",
+			FramePositions:    []FramePosition{FramePositionFirst},
+			Resolutions:       []Resolution{{1280, 720}, {720, 1280}, {1920, 1080}, {1080, 1920}},
+			Durations:         []int{8},
+			MinFPS:            24,
+			MaxFPS:            24,
+			DefaultResolution: Resolution{1280, 720},
+			DefaultDuration:   8,
+			DefaultFPS:        24,
+		},
+	}
+)
+
+// RegisterVideoCapabilities registers the capabilities of the video models whose AIR
+// starts with prefix. A provider prefix such as "klingai:" covers all of its models,
+// a model prefix such as "klingai:5@3" a single model; the longest match wins.
+// Registering nil removes an entry.
+//
+// Example:
+//
+//	models.RegisterVideoCapabilities("acme:1@1", &models.VideoCapabilities{
+//	    Resolutions:       []models.Resolution{{Width: 1280, Height: 720}},
+//	    Durations:         []int{5},
+//	    DefaultResolution: models.Resolution{Width: 1280, Height: 720},
+//	})
+func RegisterVideoCapabilities(prefix string, caps *VideoCapabilities) {
+	videoCapabilitiesMu.Lock()
+	defer videoCapabilitiesMu.Unlock()
+	if caps == nil {
+		delete(videoCapabilities, prefix)
+		return
+	}
+	videoCapabilities[prefix] = caps
+}
+
+// LookupVideoCapabilities returns the capabilities registered for the longest prefix of model
+func LookupVideoCapabilities(model string) (*VideoCapabilities, bool) {
+	videoCapabilitiesMu.RLock()
+	defer videoCapabilitiesMu.RUnlock()
+
+	var best string
+	var caps *VideoCapabilities
+	for prefix, c := range videoCapabilities {
+		if strings.HasPrefix(model, prefix) && len(prefix) > len(best) {
+			best, caps = prefix, c
+		}
+	}
+	return caps, caps != nil
+}
+
+// check reports the fields of req the capabilities do not support
+func (c *VideoCapabilities) check(v *validator, req *VideoInferenceRequest) {
+	if req.Width != nil && req.Height != nil && len(c.Resolutions) > 0 {
+		res := Resolution{*req.Width, *req.Height}
+		if !slices.Contains(c.Resolutions, res) {
+			v.add("width", "resolution %s is not supported by %s (supported: %v)", res, req.Model, c.Resolutions)
+		}
+	}
+	if req.Duration != nil && len(c.Durations) > 0 && !slices.Contains(c.Durations, *req.Duration) {
+		v.add("duration", "must be one of %v for %s, got %d", c.Durations, req.Model, *req.Duration)
+	}
+	if req.FPS != nil && c.MinFPS > 0 && c.MaxFPS > 0 && (*req.FPS < c.MinFPS || *req.FPS > c.MaxFPS) {
+		v.add("fps", "must be between %d and %d for %s, got %d", c.MinFPS, c.MaxFPS, req.Model, *req.FPS)
+	}
+	if c.FramePositions != nil {
+		for _, f := range req.FrameImages {
+			if f.Frame != "" && !slices.Contains(c.FramePositions, f.Frame) {
+				v.add("frameImages", "%s frame is not supported by %s", f.Frame, req.Model)
+			}
+		}
+	}
+	if len(req.InputAudios) > 0 && !c.InputAudio {
+		v.add("inputAudios", "are not supported by %s", req.Model)
+	}
+	if len(req.ReferenceVideos) > 0 && !c.ReferenceVideos {
+		v.add("referenceVideos", "are not supported by %s", req.Model)
+	}
+	if key := req.ProviderSettings.key(); key != "" && key != c.ProviderSettings {
+		v.add("providerSettings", "%s settings are not supported by %s", key, req.Model)
+	}
+}
+
+// key returns the provider whose settings are set, or "" if none are
+func (s *VideoProviderSettings) key() string {
+	switch {
+	case s == nil:
+		return ""
+	case s.Google != nil:
+		return "google"
+	case s.ByteDance != nil:
+		return "bytedance"
+	case s.MiniMax != nil:
+		return "minimax"
+	case s.PixVerse != nil:
+		return "pixverse"
+	case s.Vidu != nil:
+		return "vidu"
+	}
+	return ""
+}
@@ -0,0 +1,97 @@
+package models
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLookupVideoCapabilitiesLongestPrefix(t *testing.T) {
+	caps, ok := LookupVideoCapabilities("klingai:5@3")
+	if !ok || len(caps.Durations) == 0 {
+		t.Fatalf("LookupVideoCapabilities(klingai:5@3) = %+v, want the model entry", caps)
+	}
+	caps, ok = LookupVideoCapabilities("klingai:6@1")
+	if !ok || caps.Durations != nil {
+		t.Errorf("LookupVideoCapabilities(klingai:6@1) = %+v, want the provider entry", caps)
+	}
+	if _, ok := LookupVideoCapabilities("acme:1@1"); ok {
+		t.Error("LookupVideoCapabilities(acme:1@1) should not match")
+	}
+}
+
+func TestNewVideoInferenceRequestDefaults(t *testing.T) {
+	sora := NewVideoInferenceRequest("waves", "openai:3@1")
+	if *sora.Width != 1280 || *sora.Height != 720 || *sora.Duration != 4 || sora.FPS != nil {
+		t.Errorf("openai:3@1 defaults = %dx%d, %ds, fps %v", *sora.Width, *sora.Height, *sora.Duration, sora.FPS)
+	}
+	if err := sora.Validate(); err != nil {
+		t.Errorf("default request should be valid, got %v", err)
+	}
+
+	unknown := NewVideoInferenceRequest("waves", "acme:1@1")
+	if *unknown.Width != 1920 || *unknown.Height != 1080 || *unknown.FPS != 30 || unknown.Duration != nil {
+		t.Errorf("unregistered model defaults = %dx%d@%d, duration %v", *unknown.Width, *unknown.Height, *unknown.FPS, unknown.Duration)
+	}
+}
+
+func TestVideoCapabilitiesRejectUnsupportedCombinations(t *testing.T) {
+	tests := []struct {
+		name   string
+		model  string
+		modify func(*VideoInferenceRequest)
+		field  string
+	}{
+		{"Resolution", "openai:3@1", func(r *VideoInferenceRequest) { r.Width, r.Height = intPtr(1920), intPtr(1080) }, "width"},
+		{"Duration", "klingai:5@3", func(r *VideoInferenceRequest) { r.Duration = intPtr(7) }, "duration"},
+		{"FPS", "google:3@0", func(r *VideoInferenceRequest) { r.FPS = intPtr(30) }, "fps"},
+		{"LastFrame", "openai:3@1", func(r *VideoInferenceRequest) {
+			r.FrameImages = []FrameImage{{InputImage: "img", Frame: FramePositionLast}}
+		}, "frameImages"},
+		{"InputAudio", "klingai:5@3", func(r *VideoInferenceRequest) {
+			r.InputAudios = []InputAudio{{InputAudio: "audio"}}
+		}, "inputAudios"},
+		{"ProviderSettings", "google:3@0", func(r *VideoInferenceRequest) {
+			r.ProviderSettings = &VideoProviderSettings{Vidu: &ViduVideoSettings{}}
+		}, "providerSettings"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := NewVideoInferenceRequest("waves", tt.model)
+			tt.modify(req)
+			var vErr *ValidationError
+			if err := req.Validate(); !errors.As(err, &vErr) || !vErr.Has(tt.field) {
+				t.Errorf("Validate() error = %v, want %q reported", err, tt.field)
+			}
+		})
+	}
+}
+
+func TestRegisterVideoCapabilities(t *testing.T) {
+	RegisterVideoCapabilities("acme:1@1", &VideoCapabilities{
+		Durations:         []int{6},
+		DefaultResolution: Resolution{Width: 960, Height: 540},
+	})
+	defer RegisterVideoCapabilities("acme:1@1", nil)
+
+	req := NewVideoInferenceRequest("waves", "acme:1@1")
+	if *req.Width != 960 || *req.Height != 540 || req.FPS != nil {
+		t.Errorf("registered defaults not applied: %dx%d, fps %v", *req.Width, *req.Height, req.FPS)
+	}
+	req.Duration = intPtr(5)
+	if err := req.Validate(); err == nil {
+		t.Error("Validate() should reject a duration the registered model does not support")
+	}
+}
+
+func TestSupportedVideoDurationsStillApplies(t *testing.T) {
+	SupportedVideoDurations["legacy:1@1"] = []int{6}
+	defer delete(SupportedVideoDurations, "legacy:1@1")
+
+	req := NewVideoInferenceRequest("a dancer", "legacy:1@1")
+	duration := 4
+	req.Duration = &duration
+	var vErr *ValidationError
+	if err := req.Validate(); !errors.As(err, &vErr) || !vErr.Has("duration") {
+		t.Errorf("Validate() error = %v, want a duration error", err)
+	}
+}
@@ -112,3 +112,53 @@ type VideoInferenceResponse struct {
 	Seed         *int64     `json:"seed,omitempty"`
 	Cost         *float64   `json:"cost,omitempty"`
 }
+
+type VideoUpscaleRequest struct {
+	TaskType       string             `json:"taskType"`
+	TaskUUID       string             `json:"taskUUID"`
+	InputVideo     string             `json:"inputVideo"`
+	UpscaleFactor  int                `json:"upscaleFactor"`
+	Model          *string            `json:"model,omitempty"`
+	OutputType     *OutputType        `json:"outputType,omitempty"`
+	OutputFormat   *VideoOutputFormat `json:"outputFormat,omitempty"`
+	OutputQuality  *int               `json:"outputQuality,omitempty"`
+	WebhookURL     *string            `json:"webhookURL,omitempty"`
+	DeliveryMethod *DeliveryMethod    `json:"deliveryMethod,omitempty"`
+	UploadEndpoint *string            `json:"uploadEndpoint,omitempty"`
+	TTL            *int               `json:"ttl,omitempty"`
+	IncludeCost    *bool              `json:"includeCost,omitempty"`
+}
+
+type VideoUpscaleResponse struct {
+	TaskType  string     `json:"taskType"`
+	TaskUUID  string     `json:"taskUUID"`
+	Status    TaskStatus `json:"status,omitempty"`
+	VideoUUID string     `json:"videoUUID,omitempty"`
+	VideoURL  *string    `json:"videoURL,omitempty"`
+	Cost      *float64   `json:"cost,omitempty"`
+}
+
+type VideoBackgroundRemovalRequest struct {
+	TaskType       string             `json:"taskType"`
+	TaskUUID       string             `json:"taskUUID"`
+	InputVideo     string             `json:"inputVideo"`
+	Model          *string            `json:"model,omitempty"`
+	Rgba           []int              `json:"rgba,omitempty"`
+	OutputType     *OutputType        `json:"outputType,omitempty"`
+	OutputFormat   *VideoOutputFormat `json:"outputFormat,omitempty"`
+	OutputQuality  *int               `json:"outputQuality,omitempty"`
+	WebhookURL     *string            `json:"webhookURL,omitempty"`
+	DeliveryMethod *DeliveryMethod    `json:"deliveryMethod,omitempty"`
+	UploadEndpoint *string            `json:"uploadEndpoint,omitempty"`
+	TTL            *int               `json:"ttl,omitempty"`
+	IncludeCost    *bool              `json:"includeCost,omitempty"`
+}
+
+type VideoBackgroundRemovalResponse struct {
+	TaskType  string     `json:"taskType"`
+	TaskUUID  string     `json:"taskUUID"`
+	Status    TaskStatus `json:"status,omitempty"`
+	VideoUUID string     `json:"videoUUID,omitempty"`
+	VideoURL  *string    `json:"videoURL,omitempty"`
+	Cost      *float64   `json:"cost,omitempty"`
+}
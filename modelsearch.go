@@ -0,0 +1,99 @@
+package runware
+
+import (
+	"context"
+	"iter"
+
+	"github.com/google/uuid"
+
+	models "github.com/Ryank90/runware-go-sdk/models"
+)
+
+// ModelSearch searches the model catalog, returning a single page of results.
+//
+// Use Limit and Offset on the request to page through results, or ModelSearchIter
+// to walk every page.
+//
+// Example:
+//
+//	req := models.NewModelSearchRequest("realistic")
+//	category := models.ModelCategoryCheckpoint
+//	req.Category = &category
+//	page, err := client.ModelSearch(ctx, req)
+//	for _, m := range page.Results {
+//	    fmt.Println(m.Name, m.AIR)
+//	}
+func (c *Client) ModelSearch(ctx context.Context, req *models.ModelSearchRequest) (*models.ModelSearchResponse, error) {
+	if req == nil {
+		return nil, ErrInvalidRequest
+	}
+
+	if req.TaskType == "" {
+		req.TaskType = models.TaskTypeModelSearch
+	}
+
+	result, err := c.sendRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp, ok := result.(*models.ModelSearchResponse); ok {
+		return resp, nil
+	}
+	return nil, ErrInvalidResponse
+}
+
+// ModelSearchIter returns an iterator over every model matching the request,
+// fetching further pages as needed. Iteration starts at req.Offset and uses
+// req.Limit as the page size (ModelSearchMaxLimit if unset). The request is not modified.
+//
+// If a page fails, the error is yielded once and iteration stops.
+//
+// Example:
+//
+//	for m, err := range client.ModelSearchIter(ctx, models.NewModelSearchRequest("flux")) {
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	    fmt.Println(m.AIR)
+//	}
+func (c *Client) ModelSearchIter(ctx context.Context, req *models.ModelSearchRequest) iter.Seq2[models.ModelResult, error] {
+	return func(yield func(models.ModelResult, error) bool) {
+		if req == nil {
+			yield(models.ModelResult{}, ErrInvalidRequest)
+			return
+		}
+
+		page := *req
+		limit := models.ModelSearchMaxLimit
+		if req.Limit != nil && *req.Limit > 0 {
+			limit = *req.Limit
+		}
+		offset := 0
+		if req.Offset != nil {
+			offset = *req.Offset
+		}
+
+		for {
+			pageLimit, pageOffset := limit, offset
+			page.Limit, page.Offset = &pageLimit, &pageOffset
+			page.TaskUUID = uuid.New().String()
+
+			resp, err := c.ModelSearch(ctx, &page)
+			if err != nil {
+				yield(models.ModelResult{}, err)
+				return
+			}
+
+			for _, m := range resp.Results {
+				if !yield(m, nil) {
+					return
+				}
+			}
+
+			offset += len(resp.Results)
+			if len(resp.Results) == 0 || offset >= resp.TotalResults {
+				return
+			}
+		}
+	}
+}
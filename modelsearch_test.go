@@ -0,0 +1,127 @@
+package runware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Ryank90/runware-go-sdk/models"
+)
+
+// modelCatalog responds to modelSearch tasks with pages of a catalog of n models
+func modelCatalog(t *testing.T, n int, seen *[]map[string]interface{}) func(map[string]interface{}) interface{} {
+	return func(task map[string]interface{}) interface{} {
+		if task["taskType"] != models.TaskTypeModelSearch {
+			t.Errorf("taskType = %v, want modelSearch", task["taskType"])
+		}
+		*seen = append(*seen, task)
+
+		limit := int(task["limit"].(float64))
+		offset := int(task["offset"].(float64))
+		results := []map[string]interface{}{}
+		for i := offset; i < n && i < offset+limit; i++ {
+			results = append(results, map[string]interface{}{
+				"name": fmt.Sprintf("model %d", i),
+				"air":  fmt.Sprintf("civitai:%d@1", i),
+			})
+		}
+		return map[string]interface{}{"data": []map[string]interface{}{{
+			"taskType":     models.TaskTypeModelSearch,
+			"taskUUID":     task["taskUUID"],
+			"results":      results,
+			"totalResults": n,
+		}}}
+	}
+}
+
+func TestModelSearch(t *testing.T) {
+	var seen []map[string]interface{}
+	client := newHTTPTestClient(t, modelCatalog(t, 3, &seen))
+
+	req := models.NewModelSearchRequest("realistic")
+	category := models.ModelCategoryCheckpoint
+	limit, offset := 10, 0
+	req.Category, req.Limit, req.Offset = &category, &limit, &offset
+
+	resp, err := client.ModelSearch(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ModelSearch() error = %v", err)
+	}
+	if resp.TotalResults != 3 || len(resp.Results) != 3 || resp.Results[0].AIR != "civitai:0@1" {
+		t.Errorf("response = %+v, want 3 models", resp)
+	}
+	if seen[0]["search"] != "realistic" || seen[0]["category"] != "checkpoint" {
+		t.Errorf("request = %v, want search and category set", seen[0])
+	}
+}
+
+func TestModelSearchIterWalksAllPages(t *testing.T) {
+	var seen []map[string]interface{}
+	client := newHTTPTestClient(t, modelCatalog(t, 5, &seen))
+
+	req := models.NewModelSearchRequest("")
+	limit := 2
+	req.Limit = &limit
+
+	var airs []string
+	for m, err := range client.ModelSearchIter(context.Background(), req) {
+		if err != nil {
+			t.Fatalf("iteration error = %v", err)
+		}
+		airs = append(airs, m.AIR)
+	}
+
+	if len(airs) != 5 || airs[4] != "civitai:4@1" {
+		t.Errorf("models = %v, want all 5", airs)
+	}
+	if len(seen) != 3 {
+		t.Errorf("fetched %d pages, want 3", len(seen))
+	}
+	if seen[0]["taskUUID"] == seen[1]["taskUUID"] {
+		t.Error("pages should use distinct TaskUUIDs")
+	}
+	if req.Offset != nil {
+		t.Error("ModelSearchIter modified the request")
+	}
+}
+
+func TestModelSearchIterStopsEarly(t *testing.T) {
+	var seen []map[string]interface{}
+	client := newHTTPTestClient(t, modelCatalog(t, 10, &seen))
+
+	req := models.NewModelSearchRequest("")
+	limit := 2
+	req.Limit = &limit
+
+	count := 0
+	for range client.ModelSearchIter(context.Background(), req) {
+		count++
+		if count == 3 {
+			break
+		}
+	}
+	if len(seen) != 2 {
+		t.Errorf("fetched %d pages, want 2", len(seen))
+	}
+}
+
+func TestModelSearchIterYieldsError(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		return map[string]interface{}{"errors": []map[string]interface{}{{
+			"code":     "invalidApiKey",
+			"taskUUID": task["taskUUID"],
+		}}}
+	})
+
+	var errs []error
+	for _, err := range client.ModelSearchIter(context.Background(), models.NewModelSearchRequest("x")) {
+		errs = append(errs, err)
+	}
+	if len(errs) != 1 || !IsAPIError(errs[0]) {
+		t.Errorf("errors = %v, want a single APIError", errs)
+	}
+	if _, err := client.ModelSearch(context.Background(), nil); !errors.Is(err, ErrInvalidRequest) {
+		t.Errorf("ModelSearch(nil) error = %v, want ErrInvalidRequest", err)
+	}
+}
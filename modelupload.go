@@ -0,0 +1,124 @@
+package runware
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	models "github.com/Ryank90/runware-go-sdk/models"
+)
+
+// UploadProgressFunc is called with each status message emitted while a model uploads
+type UploadProgressFunc func(status *models.ModelUploadResponse)
+
+// UploadModel uploads a custom model (checkpoint, LoRA, ControlNet or embedding) and
+// waits until the API reports it ready, returning the final response with its AIR.
+//
+// The API emits a status message for each stage of the upload (validated, downloading,
+// stored, ...). If onProgress is non-nil it is called with each of them in order.
+// RequestTimeout (or the ctx deadline) bounds the wait for each status message,
+// rather than the whole upload, since large models can take a long time.
+//
+// Example:
+//
+//	req := models.NewLoRAUploadRequest("myorg:1@1", "My Style", "1.0",
+//	    "https://example.com/my-style.safetensors", "sdxl")
+//	resp, err := client.UploadModel(ctx, req, func(s *models.ModelUploadResponse) {
+//	    fmt.Println("Upload status:", s.Status)
+//	})
+//	fmt.Println("Model AIR:", resp.AIR)
+func (c *Client) UploadModel(
+	ctx context.Context,
+	req *models.ModelUploadRequest,
+	onProgress UploadProgressFunc,
+) (*models.ModelUploadResponse, error) {
+	if req == nil {
+		return nil, ErrInvalidRequest
+	}
+	if req.TaskType == "" {
+		req.TaskType = models.TaskTypeModelUpload
+	}
+
+	// Status messages are queued rather than sent on a channel so the handler never
+	// blocks the transport, which delivers HTTP responses before Send returns.
+	var (
+		mu      sync.Mutex
+		queue   []interface{}
+		sendErr error
+	)
+	notify := make(chan struct{}, 1)
+	handler := func(data interface{}, err error) {
+		mu.Lock()
+		if err != nil {
+			if sendErr == nil {
+				sendErr = wrapTransportError(err)
+			}
+		} else {
+			queue = append(queue, data)
+		}
+		mu.Unlock()
+		select {
+		case notify <- struct{}{}:
+		default:
+		}
+	}
+
+	task := &pendingTask{expectedCount: 1, release: func() {}}
+	if err := c.submitWithHandler(ctx, req, task, handler); err != nil {
+		return nil, err
+	}
+	defer task.release()
+	defer c.transport.RemoveHandler(req.TaskUUID)
+
+	// Each status message restarts the wait, but never past the ctx deadline
+	waitTimeout := func() time.Duration {
+		if deadline, ok := ctx.Deadline(); ok {
+			return time.Until(deadline)
+		}
+		return c.requestTimeout
+	}
+	waitStart := time.Now()
+	timer := time.NewTimer(waitTimeout())
+	defer timer.Stop()
+
+	for {
+		mu.Lock()
+		pending, err := queue, sendErr
+		queue = nil
+		mu.Unlock()
+
+		for _, data := range pending {
+			status, ok := data.(*models.ModelUploadResponse)
+			if !ok {
+				return nil, ErrInvalidResponse
+			}
+			c.debugLogger.Printf("Model upload %s (TaskUUID: %s): %s", req.AIR, req.TaskUUID, status.Status)
+			if onProgress != nil {
+				onProgress(status)
+			}
+			if status.Status == models.ModelUploadStatusReady {
+				return status, nil
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(pending) > 0 {
+			waitStart = time.Now()
+			timer.Reset(waitTimeout())
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-notify:
+		case <-timer.C:
+			return nil, &TimeoutError{
+				TaskType:      req.TaskType,
+				TaskUUID:      req.TaskUUID,
+				Duration:      time.Since(waitStart),
+				ExpectedCount: 1,
+			}
+		}
+	}
+}
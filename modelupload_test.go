@@ -0,0 +1,101 @@
+package runware
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ryank90/runware-go-sdk/models"
+)
+
+func TestUploadModelReportsProgress(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		if task["taskType"] != models.TaskTypeModelUpload || task["category"] != "lora" {
+			t.Errorf("task = %v, want a lora modelUpload", task)
+		}
+		data := []map[string]interface{}{}
+		for _, status := range []string{"validated", "downloading", "stored", "ready"} {
+			item := map[string]interface{}{
+				"taskType": models.TaskTypeModelUpload,
+				"taskUUID": task["taskUUID"],
+				"status":   status,
+			}
+			if status == "ready" {
+				item["air"] = task["air"]
+			}
+			data = append(data, item)
+		}
+		return map[string]interface{}{"data": data}
+	})
+
+	req := models.NewLoRAUploadRequest("myorg:1@1", "My Style", "1.0", "https://example.com/style.safetensors", "sdxl")
+	var statuses []models.ModelUploadStatus
+	resp, err := client.UploadModel(context.Background(), req, func(s *models.ModelUploadResponse) {
+		statuses = append(statuses, s.Status)
+	})
+	if err != nil {
+		t.Fatalf("UploadModel() error = %v", err)
+	}
+	if resp.AIR != "myorg:1@1" {
+		t.Errorf("AIR = %q, want myorg:1@1", resp.AIR)
+	}
+	if len(statuses) != 4 || statuses[0] != models.ModelUploadStatusValidated || statuses[3] != models.ModelUploadStatusReady {
+		t.Errorf("statuses = %v, want validated..ready", statuses)
+	}
+}
+
+func TestUploadModelAPIError(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		return map[string]interface{}{
+			"data": []map[string]interface{}{{
+				"taskType": models.TaskTypeModelUpload,
+				"taskUUID": task["taskUUID"],
+				"status":   "validated",
+			}},
+			"errors": []map[string]interface{}{{
+				"code":     "invalidDownloadURL",
+				"message":  "Could not download model",
+				"taskUUID": task["taskUUID"],
+			}},
+		}
+	})
+
+	req := models.NewCheckpointUploadRequest("myorg:2@1", "Base", "1.0", "https://example.com/bad", "sdxl", models.ModelTypeBase)
+	calls := 0
+	_, err := client.UploadModel(context.Background(), req, func(*models.ModelUploadResponse) { calls++ })
+	if !IsAPIError(err) {
+		t.Fatalf("error = %v, want APIError", err)
+	}
+	if calls != 1 {
+		t.Errorf("progress called %d times, want 1 before the error", calls)
+	}
+}
+
+func TestUploadModelTimeoutReportsWait(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		return map[string]interface{}{"data": []map[string]interface{}{{
+			"taskType": models.TaskTypeModelUpload,
+			"taskUUID": task["taskUUID"],
+			"status":   "downloading",
+		}}}
+	})
+	client.requestTimeout = 50 * time.Millisecond
+
+	req := models.NewLoRAUploadRequest("myorg:3@1", "Stalled", "1.0", "https://example.com/stalled.safetensors", "sdxl")
+	_, err := client.UploadModel(context.Background(), req, nil)
+	var timeoutErr *TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("error = %v, want TimeoutError", err)
+	}
+	if timeoutErr.Duration < client.requestTimeout || timeoutErr.Duration > time.Second {
+		t.Errorf("Duration = %v, want the time waited since the last status", timeoutErr.Duration)
+	}
+}
+
+func TestUploadModelNilRequest(t *testing.T) {
+	client := newHTTPTestClient(t, func(map[string]interface{}) interface{} { return nil })
+	if _, err := client.UploadModel(context.Background(), nil, nil); err != ErrInvalidRequest {
+		t.Errorf("error = %v, want ErrInvalidRequest", err)
+	}
+}
@@ -0,0 +1,186 @@
+package runware
+
+import (
+	"context"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// RateLimit configures client-side admission control for outgoing requests.
+//
+// Requests that exceed the limit are queued until they can be sent, or until
+// their context is canceled. Use Client.QueueDepth to monitor how many requests
+// are waiting.
+//
+// Example:
+//
+//	config := runware.DefaultConfig()
+//	config.RateLimit = &runware.RateLimit{
+//	    RequestsPerSecond: 5,
+//	    MaxInFlight:       10,
+//	    TaskTypeLimits: map[string]*runware.RateLimit{
+//	        models.TaskTypeVideoInference: {MaxInFlight: 2},
+//	    },
+//	}
+type RateLimit struct {
+	// RequestsPerSecond is the sustained rate at which requests are sent.
+	// Zero means no rate limit.
+	RequestsPerSecond float64
+
+	// Burst is the number of requests that may be sent at once before
+	// RequestsPerSecond applies. Defaults to RequestsPerSecond rounded up (minimum 1).
+	Burst int
+
+	// MaxInFlight caps the number of requests awaiting a response at the same time.
+	// Zero means no cap.
+	MaxInFlight int
+
+	// TaskTypeLimits sets additional limits for specific task types
+	// (e.g. models.TaskTypeImageInference). They apply on top of the global limit.
+	TaskTypeLimits map[string]*RateLimit
+}
+
+// tokenLimiter enforces a single RateLimit with a token bucket and an in-flight semaphore
+type tokenLimiter struct {
+	mu       sync.Mutex
+	rate     float64
+	burst    float64
+	tokens   float64
+	last     time.Time
+	inFlight chan struct{}
+}
+
+// newTokenLimiter creates a limiter for the given limit, or nil if it imposes no limit
+func newTokenLimiter(limit *RateLimit) *tokenLimiter {
+	if limit == nil || (limit.RequestsPerSecond <= 0 && limit.MaxInFlight <= 0) {
+		return nil
+	}
+
+	l := &tokenLimiter{rate: limit.RequestsPerSecond, last: time.Now()}
+	if l.rate > 0 {
+		l.burst = float64(limit.Burst)
+		if l.burst <= 0 {
+			l.burst = math.Max(math.Ceil(l.rate), 1)
+		}
+		l.tokens = l.burst
+	}
+	if limit.MaxInFlight > 0 {
+		l.inFlight = make(chan struct{}, limit.MaxInFlight)
+	}
+	return l
+}
+
+// acquire blocks until a request may be sent. The returned release func must be
+// called once the request has completed.
+func (l *tokenLimiter) acquire(ctx context.Context) (func(), error) {
+	if l.inFlight != nil {
+		select {
+		case l.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := func() {
+		if l.inFlight != nil {
+			<-l.inFlight
+		}
+	}
+
+	if err := l.takeToken(ctx); err != nil {
+		release()
+		return nil, err
+	}
+	return release, nil
+}
+
+// takeToken waits for and consumes a token from the bucket
+func (l *tokenLimiter) takeToken(ctx context.Context) error {
+	if l.rate <= 0 {
+		return nil
+	}
+
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+		l.last = now
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+		wait := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		l.mu.Unlock()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// rateLimiter applies the global limit and any per-task-type limits
+type rateLimiter struct {
+	global   *tokenLimiter
+	taskType map[string]*tokenLimiter
+	queued   atomic.Int64
+}
+
+// newRateLimiter creates a rate limiter from the configuration, or nil if limit is nil
+func newRateLimiter(limit *RateLimit) *rateLimiter {
+	if limit == nil {
+		return nil
+	}
+
+	r := &rateLimiter{
+		global:   newTokenLimiter(limit),
+		taskType: make(map[string]*tokenLimiter, len(limit.TaskTypeLimits)),
+	}
+	for taskType, l := range limit.TaskTypeLimits {
+		if tl := newTokenLimiter(l); tl != nil {
+			r.taskType[taskType] = tl
+		}
+	}
+	return r
+}
+
+// acquire waits for admission under the task type limit and then the global limit.
+// The returned release func must be called once the request has completed.
+func (r *rateLimiter) acquire(ctx context.Context, taskType string) (func(), error) {
+	r.queued.Add(1)
+	defer r.queued.Add(-1)
+
+	var releases []func()
+	release := func() {
+		for i := len(releases) - 1; i >= 0; i-- {
+			releases[i]()
+		}
+	}
+
+	for _, l := range []*tokenLimiter{r.taskType[taskType], r.global} {
+		if l == nil {
+			continue
+		}
+		rel, err := l.acquire(ctx)
+		if err != nil {
+			release()
+			return nil, err
+		}
+		releases = append(releases, rel)
+	}
+	return release, nil
+}
+
+// QueueDepth returns the number of requests currently waiting for admission
+// under Config.RateLimit. It is always zero when no rate limit is configured.
+func (c *Client) QueueDepth() int {
+	if c.limiter == nil {
+		return 0
+	}
+	return int(c.limiter.queued.Load())
+}
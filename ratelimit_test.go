@@ -0,0 +1,157 @@
+package runware
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Ryank90/runware-go-sdk/models"
+)
+
+func TestTokenLimiterPacesRequests(t *testing.T) {
+	l := newTokenLimiter(&RateLimit{RequestsPerSecond: 20, Burst: 2})
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		release, err := l.acquire(context.Background())
+		if err != nil {
+			t.Fatalf("acquire() error = %v", err)
+		}
+		release()
+	}
+
+	// Two requests use the burst, the other two wait ~50ms each
+	if elapsed := time.Since(start); elapsed < 80*time.Millisecond {
+		t.Errorf("4 requests at 20/s with burst 2 took %v, want >= 80ms", elapsed)
+	}
+}
+
+func TestNewTokenLimiterNoLimit(t *testing.T) {
+	if l := newTokenLimiter(&RateLimit{}); l != nil {
+		t.Error("empty RateLimit should not create a limiter")
+	}
+	if r := newRateLimiter(nil); r != nil {
+		t.Error("nil RateLimit should not create a rate limiter")
+	}
+}
+
+func TestRateLimiterMaxInFlightQueues(t *testing.T) {
+	r := newRateLimiter(&RateLimit{MaxInFlight: 1})
+	client := &Client{limiter: r}
+
+	release, err := r.acquire(context.Background(), models.TaskTypeImageInference)
+	if err != nil {
+		t.Fatalf("acquire() error = %v", err)
+	}
+
+	admitted := make(chan struct{})
+	go func() {
+		rel, err := r.acquire(context.Background(), models.TaskTypeImageInference)
+		if err == nil {
+			rel()
+		}
+		close(admitted)
+	}()
+
+	deadline := time.Now().Add(time.Second)
+	for client.QueueDepth() != 1 {
+		if time.Now().After(deadline) {
+			t.Fatalf("QueueDepth() = %d, want 1", client.QueueDepth())
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	release()
+	select {
+	case <-admitted:
+	case <-time.After(time.Second):
+		t.Fatal("queued request was not admitted after release")
+	}
+	if depth := client.QueueDepth(); depth != 0 {
+		t.Errorf("QueueDepth() = %d after admission, want 0", depth)
+	}
+}
+
+func TestRateLimiterRespectsContextWhileQueued(t *testing.T) {
+	r := newRateLimiter(&RateLimit{MaxInFlight: 1})
+
+	release, err := r.acquire(context.Background(), models.TaskTypeImageInference)
+	if err != nil {
+		t.Fatalf("acquire() error = %v", err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := r.acquire(ctx, models.TaskTypeImageInference); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("acquire() error = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestRateLimiterTaskTypeLimits(t *testing.T) {
+	r := newRateLimiter(&RateLimit{
+		TaskTypeLimits: map[string]*RateLimit{
+			models.TaskTypeVideoInference: {MaxInFlight: 1},
+		},
+	})
+
+	release, err := r.acquire(context.Background(), models.TaskTypeVideoInference)
+	if err != nil {
+		t.Fatalf("acquire() error = %v", err)
+	}
+	defer release()
+
+	// Other task types are not limited by the video limit
+	imgRelease, err := r.acquire(context.Background(), models.TaskTypeImageInference)
+	if err != nil {
+		t.Fatalf("acquire() for image error = %v", err)
+	}
+	imgRelease()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := r.acquire(ctx, models.TaskTypeVideoInference); err == nil {
+		t.Error("second video request should wait for the first to complete")
+	}
+}
+
+func TestClientRateLimitBoundsConcurrency(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, maxSeen := 0, 0
+
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxSeen {
+			maxSeen = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		return map[string]interface{}{"data": []map[string]interface{}{{
+			"taskType":  task["taskType"],
+			"taskUUID":  task["taskUUID"],
+			"imageUUID": "img",
+		}}}
+	})
+	client.limiter = newRateLimiter(&RateLimit{MaxInFlight: 2})
+
+	requests := make([]*models.ImageInferenceRequest, 8)
+	for i := range requests {
+		requests[i] = models.NewImageInferenceRequest(testPrompt, testModel, 512, 512)
+	}
+	if _, err := client.ImageInferenceBatch(context.Background(), requests); err != nil {
+		t.Fatalf("ImageInferenceBatch() error = %v", err)
+	}
+
+	if maxSeen > 2 {
+		t.Errorf("server saw %d concurrent requests, want at most 2", maxSeen)
+	}
+}
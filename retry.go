@@ -0,0 +1,228 @@
+package runware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	wsinternal "github.com/Ryank90/runware-go-sdk/internal/ws"
+	models "github.com/Ryank90/runware-go-sdk/models"
+)
+
+// RetryPolicy configures automatic retries of failed requests.
+//
+// Requests are retried when the API returns a retryable *APIError (see
+// APIError.IsRetryable), and optionally on timeouts and connection loss.
+// Each retry is submitted as a copy of the request under a fresh TaskUUID, so
+// the caller's request keeps its original TaskUUID. GetResponseRequest is the
+// exception: its TaskUUID names the task being polled, so it is retried as is,
+// which is safe because polling is idempotent. The backoff between attempts
+// grows exponentially with random jitter.
+//
+// Example:
+//
+//	config := runware.DefaultConfig()
+//	config.RetryPolicy = runware.DefaultRetryPolicy()
+//	config.RetryPolicy.TaskTypeOverrides = map[string]*runware.RetryPolicy{
+//	    models.TaskTypeVideoInference: {MaxAttempts: 1}, // never retry video
+//	}
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first.
+	// Values of 1 or less disable retries.
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the delay between attempts.
+	MaxBackoff time.Duration
+
+	// Multiplier scales the backoff after each attempt. Values below 1 are treated as 1.
+	Multiplier float64
+
+	// Jitter randomizes each backoff by up to this fraction (0-1) in either direction.
+	Jitter float64
+
+	// RetryOnTimeout retries requests that fail with a *TimeoutError. A timed-out
+	// task may still complete on the API, so retrying it can run (and bill) the task
+	// twice. Consider enabling this only for cheap or idempotent task types through
+	// TaskTypeOverrides.
+	RetryOnTimeout bool
+
+	// RetryOnConnectionClosed retries requests that fail because the connection
+	// was closed or is being re-established.
+	RetryOnConnectionClosed bool
+
+	// TaskTypeOverrides replaces the policy for specific task types
+	// (e.g. models.TaskTypeVideoInference).
+	TaskTypeOverrides map[string]*RetryPolicy
+}
+
+// DefaultRetryPolicy returns a retry policy with sensible defaults:
+// 3 attempts, backoff starting at 1 second doubling up to 30 seconds with 20% jitter,
+// retrying on connection loss but not on timeouts.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:             3,
+		InitialBackoff:          time.Second,
+		MaxBackoff:              30 * time.Second,
+		Multiplier:              2,
+		Jitter:                  0.2,
+		RetryOnTimeout:          false,
+		RetryOnConnectionClosed: true,
+	}
+}
+
+// forTaskType returns the policy that applies to the given task type
+func (p *RetryPolicy) forTaskType(taskType string) *RetryPolicy {
+	if override, ok := p.TaskTypeOverrides[taskType]; ok && override != nil {
+		return override
+	}
+	return p
+}
+
+// shouldRetry reports whether err is worth retrying under this policy
+func (p *RetryPolicy) shouldRetry(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.IsRetryable()
+	}
+	if p.RetryOnTimeout && IsTimeout(err) {
+		return true
+	}
+	if p.RetryOnConnectionClosed && (errors.Is(err, ErrConnectionClosed) || errors.Is(err, ErrNotConnected)) {
+		return true
+	}
+	return false
+}
+
+// backoff returns the delay before the given retry (1-based)
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	multiplier := math.Max(p.Multiplier, 1)
+	delay := float64(p.InitialBackoff) * math.Pow(multiplier, float64(retry-1))
+	if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		delay *= 1 + jitter*(2*rand.Float64()-1)
+	}
+	return time.Duration(delay)
+}
+
+// RetryAttempt records the outcome of a single attempt of a retried request
+type RetryAttempt struct {
+	// TaskUUID is the task identifier used for this attempt
+	TaskUUID string
+	// Err is the error the attempt failed with
+	Err error
+	// Duration is how long the attempt took
+	Duration time.Duration
+}
+
+// RetryError is returned when a request still fails after being retried.
+//
+// It unwraps to the final error, so errors.Is and errors.As (for example with
+// *APIError or *TimeoutError) continue to work on the result.
+type RetryError struct {
+	// Attempts lists every attempt in order
+	Attempts []RetryAttempt
+	// Err is the error that ended retrying
+	Err error
+}
+
+// Error implements the error interface
+func (e *RetryError) Error() string {
+	history := make([]string, len(e.Attempts))
+	for i, a := range e.Attempts {
+		history[i] = fmt.Sprintf("#%d %s: %v", i+1, a.TaskUUID, a.Err)
+	}
+	return fmt.Sprintf("request failed after %d attempts: %v [%s]",
+		len(e.Attempts), e.Err, strings.Join(history, "; "))
+}
+
+// Unwrap returns the final error
+func (e *RetryError) Unwrap() error { return e.Err }
+
+// sendWithRetry sends a request, retrying according to the configured policy
+func (c *Client) sendWithRetry(ctx context.Context, req interface{}) ([]interface{}, error) {
+	var policy *RetryPolicy
+	if c.config != nil {
+		policy = c.config.RetryPolicy
+	}
+	var taskType string
+	if ti, ok := req.(models.TaskIdentifiable); ok {
+		taskType = ti.GetTaskType()
+	}
+	if policy != nil {
+		policy = policy.forTaskType(taskType)
+	}
+	// Streamed uploads consume their content, so they cannot be sent again
+	if _, streamed := req.(*wsinternal.StreamedRequest); streamed {
+		policy = nil
+	}
+	if policy == nil || policy.MaxAttempts <= 1 {
+		return c.sendOnce(ctx, req)
+	}
+
+	var attempts []RetryAttempt
+	for attempt := 1; ; attempt++ {
+		var taskUUID string
+		if ti, ok := req.(models.TaskIdentifiable); ok {
+			taskUUID = ti.GetTaskUUID()
+		}
+
+		start := time.Now()
+		results, err := c.sendOnce(ctx, req)
+		if err == nil {
+			return results, nil
+		}
+		attempts = append(attempts, RetryAttempt{TaskUUID: taskUUID, Err: err, Duration: time.Since(start)})
+
+		// Never retry once results have been delivered: that would pay for them twice
+		if len(results) > 0 || attempt >= policy.MaxAttempts || !policy.shouldRetry(err) {
+			if len(attempts) == 1 {
+				return results, err
+			}
+			return results, &RetryError{Attempts: attempts, Err: err}
+		}
+
+		delay := policy.backoff(attempt)
+		c.debugLogger.Printf("Attempt %d/%d for %s (TaskUUID: %s) failed: %v - retrying in %v",
+			attempt, policy.MaxAttempts, taskType, taskUUID, err, delay)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, &RetryError{Attempts: attempts, Err: ctx.Err()}
+		case <-timer.C:
+		}
+
+		req = withFreshTaskUUID(req)
+	}
+}
+
+// withFreshTaskUUID returns a shallow copy of req under a new TaskUUID, leaving the
+// caller's request untouched. Requests without a settable TaskUUID are returned as is.
+func withFreshTaskUUID(req interface{}) interface{} {
+	if _, ok := req.(models.TaskUUIDSetter); !ok {
+		return req
+	}
+	v := reflect.ValueOf(req)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return req
+	}
+	clone := reflect.New(v.Elem().Type())
+	clone.Elem().Set(v.Elem())
+	copied := clone.Interface()
+	copied.(models.TaskUUIDSetter).SetTaskUUID(uuid.New().String())
+	return copied
+}
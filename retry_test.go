@@ -0,0 +1,232 @@
+package runware
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	wsinternal "github.com/Ryank90/runware-go-sdk/internal/ws"
+	"github.com/Ryank90/runware-go-sdk/models"
+)
+
+func fastRetryPolicy(attempts int) *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    attempts,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+		Multiplier:     2,
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := &RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+	}
+
+	tests := []struct {
+		retry int
+		want  time.Duration
+	}{
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{3, 400 * time.Millisecond},
+		{5, time.Second},
+	}
+	for _, tt := range tests {
+		if got := policy.backoff(tt.retry); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.retry, got, tt.want)
+		}
+	}
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		got := policy.backoff(1)
+		if got < 50*time.Millisecond || got > 150*time.Millisecond {
+			t.Fatalf("backoff with jitter = %v, want within 50ms-150ms", got)
+		}
+	}
+}
+
+func TestRetryPolicyShouldRetry(t *testing.T) {
+	policy := DefaultRetryPolicy()
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"retryable API error", &APIError{ErrorID: "rateLimitExceeded"}, true},
+		{"permanent API error", &APIError{ErrorID: "invalidModel"}, false},
+		{"timeout", &TimeoutError{TaskType: "imageInference"}, false},
+		{"connection closed", ErrConnectionClosed, true},
+		{"invalid request", ErrInvalidRequest, false},
+		{"context canceled", context.Canceled, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.shouldRetry(tt.err); got != tt.want {
+				t.Errorf("shouldRetry(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+
+	policy.RetryOnTimeout = true
+	if !policy.shouldRetry(&TimeoutError{TaskType: "imageInference"}) {
+		t.Error("shouldRetry(timeout) = false with RetryOnTimeout enabled")
+	}
+}
+
+func TestRetryWithFreshTaskUUID(t *testing.T) {
+	var mu sync.Mutex
+	var seen []string
+
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		mu.Lock()
+		defer mu.Unlock()
+		seen = append(seen, task["taskUUID"].(string))
+		if len(seen) == 1 {
+			return map[string]interface{}{"errors": []map[string]interface{}{{
+				"code":     "rateLimitExceeded",
+				"message":  "Too many requests",
+				"taskUUID": task["taskUUID"],
+			}}}
+		}
+		return map[string]interface{}{"data": []map[string]interface{}{{
+			"taskType":  task["taskType"],
+			"taskUUID":  task["taskUUID"],
+			"imageUUID": "img",
+		}}}
+	})
+	client.config.RetryPolicy = fastRetryPolicy(3)
+
+	req := models.NewImageInferenceRequest(testPrompt, testModel, 512, 512)
+	originalUUID := req.TaskUUID
+	resp, err := client.ImageInference(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ImageInference() error = %v", err)
+	}
+	if resp.ImageUUID != "img" {
+		t.Errorf("ImageUUID = %q, want img", resp.ImageUUID)
+	}
+	if len(seen) != 2 {
+		t.Fatalf("server saw %d attempts, want 2", len(seen))
+	}
+	if seen[0] == seen[1] {
+		t.Error("retry reused the original TaskUUID")
+	}
+	if req.TaskUUID != originalUUID || seen[0] != originalUUID {
+		t.Errorf("caller's TaskUUID changed to %q, want %q", req.TaskUUID, originalUUID)
+	}
+}
+
+func TestRetryExhaustedReportsHistory(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		return map[string]interface{}{"errors": []map[string]interface{}{{
+			"code":     "serviceUnavailable",
+			"message":  "Try again later",
+			"taskUUID": task["taskUUID"],
+		}}}
+	})
+	client.config.RetryPolicy = fastRetryPolicy(3)
+
+	_, err := client.TextToImage(context.Background(), testPrompt, testModel, 512, 512)
+
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) {
+		t.Fatalf("error = %v, want *RetryError", err)
+	}
+	if len(retryErr.Attempts) != 3 {
+		t.Errorf("recorded %d attempts, want 3", len(retryErr.Attempts))
+	}
+	if !IsAPIError(err) {
+		t.Error("RetryError should unwrap to the final *APIError")
+	}
+}
+
+func TestRetrySkipsPermanentErrors(t *testing.T) {
+	calls := 0
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		calls++
+		return map[string]interface{}{"errors": []map[string]interface{}{{
+			"code":     "invalidModel",
+			"message":  "Unknown model",
+			"taskUUID": task["taskUUID"],
+		}}}
+	})
+	client.config.RetryPolicy = fastRetryPolicy(3)
+
+	_, err := client.TextToImage(context.Background(), testPrompt, testModel, 512, 512)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("error = %v, want *APIError", err)
+	}
+	var retryErr *RetryError
+	if errors.As(err, &retryErr) {
+		t.Error("single attempt should not be wrapped in RetryError")
+	}
+	if calls != 1 {
+		t.Errorf("server saw %d attempts, want 1", calls)
+	}
+}
+
+func TestRetryTaskTypeOverride(t *testing.T) {
+	calls := 0
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		calls++
+		return map[string]interface{}{"errors": []map[string]interface{}{{
+			"code":     "rateLimitExceeded",
+			"taskUUID": task["taskUUID"],
+		}}}
+	})
+	client.config.RetryPolicy = fastRetryPolicy(3)
+	client.config.RetryPolicy.TaskTypeOverrides = map[string]*RetryPolicy{
+		models.TaskTypeImageInference: {MaxAttempts: 1},
+	}
+
+	if _, err := client.TextToImage(context.Background(), testPrompt, testModel, 512, 512); err == nil {
+		t.Fatal("TextToImage() should fail")
+	}
+	if calls != 1 {
+		t.Errorf("server saw %d attempts, want 1 with override", calls)
+	}
+}
+
+// silentTransport accepts requests but never responds
+type silentTransport struct {
+	mu      sync.Mutex
+	removed []string
+}
+
+func (s *silentTransport) Connect(context.Context) error { return nil }
+func (s *silentTransport) Disconnect() error             { return nil }
+func (s *silentTransport) IsConnected() bool             { return true }
+func (s *silentTransport) Send(context.Context, interface{}, wsinternal.ResponseHandler) error {
+	return nil
+}
+func (s *silentTransport) RemoveHandler(taskUUID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.removed = append(s.removed, taskUUID)
+}
+
+func TestTimedOutAttemptRemovesHandler(t *testing.T) {
+	transport := &silentTransport{}
+	client := &Client{
+		transport:      transport,
+		config:         DefaultConfig(),
+		requestTimeout: 20 * time.Millisecond,
+		debugLogger:    &defaultLogger{},
+	}
+
+	req := models.NewImageInferenceRequest(testPrompt, testModel, 512, 512)
+	if _, err := client.ImageInference(context.Background(), req); !IsTimeout(err) {
+		t.Fatalf("ImageInference() error = %v, want a timeout", err)
+	}
+	if len(transport.removed) != 1 || transport.removed[0] != req.TaskUUID {
+		t.Errorf("removed handlers = %v, want the timed-out task %s", transport.removed, req.TaskUUID)
+	}
+}
@@ -0,0 +1,186 @@
+package runware
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	models "github.com/Ryank90/runware-go-sdk/models"
+)
+
+// resultSink receives untyped responses forwarded from a pending task
+type resultSink interface {
+	push(resp interface{}) bool
+	finish(err error)
+}
+
+// ResultStream delivers the responses of a multi-result task as they arrive.
+//
+// Results are sent on the channel returned by Results() the moment the API
+// delivers them. The channel is closed when every expected result has been
+// received, or when the task fails, times out or its context is canceled.
+// After the channel is closed, Err() reports why the stream ended (nil on success).
+//
+// Example:
+//
+//	stream, err := client.ImageInferenceStream(ctx, req)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for img := range stream.Results() {
+//	    fmt.Println("Image ready:", *img.ImageURL)
+//	}
+//	if err := stream.Err(); err != nil {
+//	    log.Printf("stream ended early: %v", err)
+//	}
+type ResultStream[T any] struct {
+	results chan T
+	done    chan struct{}
+	mu      sync.Mutex
+	err     error
+}
+
+// Results returns the channel on which responses are delivered
+func (s *ResultStream[T]) Results() <-chan T { return s.results }
+
+// Done returns a channel that is closed once the stream has finished
+func (s *ResultStream[T]) Done() <-chan struct{} { return s.done }
+
+// Err returns the error that ended the stream, or nil if every result was delivered.
+// It should be called after the Results channel has been closed.
+func (s *ResultStream[T]) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// push delivers a raw response to the stream, reporting false if it has the wrong type
+func (s *ResultStream[T]) push(resp interface{}) bool {
+	typed, ok := resp.(T)
+	if !ok {
+		return false
+	}
+	s.results <- typed
+	return true
+}
+
+// finish records the terminal error and closes the stream
+func (s *ResultStream[T]) finish(err error) {
+	s.mu.Lock()
+	s.err = err
+	s.mu.Unlock()
+	close(s.results)
+	close(s.done)
+}
+
+// ImageInferenceStream submits an image inference request and streams each generated
+// image as soon as it is delivered, instead of waiting for all NumberResults images.
+//
+// Returns ErrNotConnected if the client is not connected.
+// Returns ErrInvalidRequest if the request is nil.
+func (c *Client) ImageInferenceStream(
+	ctx context.Context,
+	req *models.ImageInferenceRequest,
+) (*ResultStream[*models.ImageInferenceResponse], error) {
+	if req == nil {
+		return nil, ErrInvalidRequest
+	}
+
+	if req.TaskType == "" {
+		req.TaskType = models.TaskTypeImageInference
+	}
+
+	return streamRequest[*models.ImageInferenceResponse](ctx, c, req)
+}
+
+// VideoInferenceStream submits a video inference request and streams each response as it arrives
+func (c *Client) VideoInferenceStream(
+	ctx context.Context,
+	req *models.VideoInferenceRequest,
+) (*ResultStream[*models.VideoInferenceResponse], error) {
+	if req == nil {
+		return nil, ErrInvalidRequest
+	}
+
+	if req.TaskType == "" {
+		req.TaskType = models.TaskTypeVideoInference
+	}
+
+	return streamRequest[*models.VideoInferenceResponse](ctx, c, req)
+}
+
+// AudioInferenceStream submits an audio inference request and streams each response as it arrives
+func (c *Client) AudioInferenceStream(
+	ctx context.Context,
+	req *models.AudioInferenceRequest,
+) (*ResultStream[*models.AudioInferenceResponse], error) {
+	if req == nil {
+		return nil, ErrInvalidRequest
+	}
+
+	return streamRequest[*models.AudioInferenceResponse](ctx, c, req)
+}
+
+// streamRequest submits a request and forwards typed responses to a ResultStream
+func streamRequest[T any](ctx context.Context, c *Client, req interface{}) (*ResultStream[T], error) {
+	task, err := c.submitRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	stream := &ResultStream[T]{
+		results: make(chan T, task.expectedCount),
+		done:    make(chan struct{}),
+	}
+	go c.forwardResults(ctx, task, stream)
+	return stream, nil
+}
+
+// forwardResults relays responses from a pending task to the stream until it completes
+func (c *Client) forwardResults(ctx context.Context, task *pendingTask, stream resultSink) {
+	timeout := c.requestTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+	startTime := time.Now()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	defer task.release()
+
+	received := 0
+	for {
+		select {
+		case <-ctx.Done():
+			c.transport.RemoveHandler(task.taskUUID)
+			stream.finish(ctx.Err())
+			return
+		case err := <-task.errChan:
+			c.transport.RemoveHandler(task.taskUUID)
+			stream.finish(err)
+			return
+		case resp, ok := <-task.respChan:
+			if !ok {
+				stream.finish(nil)
+				return
+			}
+			if !stream.push(resp) {
+				c.transport.RemoveHandler(task.taskUUID)
+				stream.finish(ErrInvalidResponse)
+				return
+			}
+			received++
+			c.debugLogger.Printf("Streamed %d/%d results for %s (TaskUUID: %s)",
+				received, task.expectedCount, task.taskType, task.taskUUID)
+		case <-timer.C:
+			c.transport.RemoveHandler(task.taskUUID)
+			stream.finish(&TimeoutError{
+				TaskType:      task.taskType,
+				TaskUUID:      task.taskUUID,
+				Duration:      time.Since(startTime),
+				ExpectedCount: task.expectedCount,
+				ReceivedCount: received,
+			})
+			return
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package runware
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ryank90/runware-go-sdk/models"
+)
+
+func newStreamTestClient(t *testing.T, timeout time.Duration) *Client {
+	t.Helper()
+	config := DefaultConfig()
+	config.APIKey = testAPIKey
+	config.RequestTimeout = timeout
+	client, err := NewClient(config)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return client
+}
+
+func newTestPendingTask(client *Client, expected int) (*pendingTask, func(interface{}, error)) {
+	task := &pendingTask{
+		taskType:      models.TaskTypeImageInference,
+		taskUUID:      testUUID,
+		expectedCount: expected,
+		respChan:      make(chan interface{}, expected),
+		errChan:       make(chan error, 1),
+		release:       func() {},
+	}
+	return task, client.createResponseHandler(expected, task.respChan, task.errChan, nil)
+}
+
+func TestForwardResultsDeliversEachResult(t *testing.T) {
+	client := newStreamTestClient(t, 5*time.Second)
+	task, handler := newTestPendingTask(client, 3)
+
+	stream := &ResultStream[*models.ImageInferenceResponse]{
+		results: make(chan *models.ImageInferenceResponse, task.expectedCount),
+		done:    make(chan struct{}),
+	}
+	go client.forwardResults(context.Background(), task, stream)
+
+	// The first result must be readable before the others are delivered
+	handler(&models.ImageInferenceResponse{ImageUUID: "img-0"}, nil)
+	select {
+	case img := <-stream.Results():
+		if img.ImageUUID != "img-0" {
+			t.Errorf("first result ImageUUID = %q, want img-0", img.ImageUUID)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("first result was not streamed before the task completed")
+	}
+
+	handler(&models.ImageInferenceResponse{ImageUUID: "img-1"}, nil)
+	handler(&models.ImageInferenceResponse{ImageUUID: "img-2"}, nil)
+
+	count := 1
+	for range stream.Results() {
+		count++
+	}
+	if count != 3 {
+		t.Errorf("received %d results, want 3", count)
+	}
+	if err := stream.Err(); err != nil {
+		t.Errorf("Err() = %v, want nil", err)
+	}
+}
+
+func TestForwardResultsAPIError(t *testing.T) {
+	client := newStreamTestClient(t, 5*time.Second)
+	task, handler := newTestPendingTask(client, 2)
+
+	stream := &ResultStream[*models.ImageInferenceResponse]{
+		results: make(chan *models.ImageInferenceResponse, task.expectedCount),
+		done:    make(chan struct{}),
+	}
+	go client.forwardResults(context.Background(), task, stream)
+
+	apiErr := &APIError{Message: "boom", TaskUUID: testUUID}
+	handler(nil, apiErr)
+
+	<-stream.Done()
+	if !errors.Is(stream.Err(), apiErr) {
+		t.Errorf("Err() = %v, want %v", stream.Err(), apiErr)
+	}
+}
+
+func TestForwardResultsTimeout(t *testing.T) {
+	client := newStreamTestClient(t, 50*time.Millisecond)
+	task, handler := newTestPendingTask(client, 2)
+
+	stream := &ResultStream[*models.ImageInferenceResponse]{
+		results: make(chan *models.ImageInferenceResponse, task.expectedCount),
+		done:    make(chan struct{}),
+	}
+	go client.forwardResults(context.Background(), task, stream)
+	handler(&models.ImageInferenceResponse{ImageUUID: "img-0"}, nil)
+
+	var got int
+	for range stream.Results() {
+		got++
+	}
+	if got != 1 {
+		t.Errorf("received %d results, want 1", got)
+	}
+
+	var timeoutErr *TimeoutError
+	if !errors.As(stream.Err(), &timeoutErr) {
+		t.Fatalf("Err() = %v, want *TimeoutError", stream.Err())
+	}
+	if timeoutErr.ReceivedCount != 1 {
+		t.Errorf("ReceivedCount = %d, want 1", timeoutErr.ReceivedCount)
+	}
+}
+
+func TestForwardResultsContextCanceled(t *testing.T) {
+	client := newStreamTestClient(t, 5*time.Second)
+	task, _ := newTestPendingTask(client, 2)
+
+	stream := &ResultStream[*models.ImageInferenceResponse]{
+		results: make(chan *models.ImageInferenceResponse, task.expectedCount),
+		done:    make(chan struct{}),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	go client.forwardResults(ctx, task, stream)
+	cancel()
+
+	<-stream.Done()
+	if !errors.Is(stream.Err(), context.Canceled) {
+		t.Errorf("Err() = %v, want context.Canceled", stream.Err())
+	}
+}
+
+func TestImageInferenceStreamWithoutConnection(t *testing.T) {
+	client := newStreamTestClient(t, 5*time.Second)
+
+	if _, err := client.ImageInferenceStream(context.Background(), nil); err != ErrInvalidRequest {
+		t.Errorf("ImageInferenceStream(nil) error = %v, want %v", err, ErrInvalidRequest)
+	}
+
+	req := models.NewImageInferenceRequest("test", "model", 512, 512)
+	if _, err := client.ImageInferenceStream(context.Background(), req); err != ErrNotConnected {
+		t.Errorf("ImageInferenceStream() error = %v, want %v", err, ErrNotConnected)
+	}
+}
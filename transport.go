@@ -0,0 +1,56 @@
+package runware
+
+import (
+	"context"
+
+	restinternal "github.com/Ryank90/runware-go-sdk/internal/rest"
+	wsinternal "github.com/Ryank90/runware-go-sdk/internal/ws"
+)
+
+// TransportType selects how the client communicates with the Runware API
+type TransportType string
+
+const (
+	// TransportWebSocket keeps a persistent, multiplexed WebSocket connection (default)
+	TransportWebSocket TransportType = "websocket"
+	// TransportHTTP sends each task as an HTTP POST, suited to serverless functions
+	// and short-lived jobs that can't hold a connection open
+	TransportHTTP TransportType = "http"
+)
+
+// transport is implemented by the WebSocket and HTTP clients.
+// Responses and API errors for a task are delivered to the handler passed to Send.
+type transport interface {
+	Connect(ctx context.Context) error
+	Disconnect() error
+	IsConnected() bool
+	Send(ctx context.Context, request interface{}, handler wsinternal.ResponseHandler) error
+	RemoveHandler(taskUUID string)
+}
+
+// newTransport creates the transport selected by the configuration
+func newTransport(config *Config, debugLogger DebugLogger) transport {
+	switch config.Transport {
+	case TransportHTTP:
+		rest := restinternal.NewClient(config.APIKey, config.HTTPConfig, debugLogger)
+		if onError := errorHandler(config); onError != nil {
+			rest.SetErrorHandler(onError)
+		}
+		return rest
+	default:
+		ws := wsinternal.NewClient(config.APIKey, config.WSConfig, debugLogger)
+		if onError := errorHandler(config); onError != nil {
+			ws.SetErrorHandler(onError)
+		}
+		return ws
+	}
+}
+
+// errorHandler adapts Config.OnError to the transports, delivering API errors as *APIError
+func errorHandler(config *Config) wsinternal.ErrorHandler {
+	if config.OnError == nil {
+		return nil
+	}
+	onError := config.OnError
+	return func(err error) { onError(wrapTransportError(err)) }
+}
@@ -0,0 +1,125 @@
+package runware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Ryank90/runware-go-sdk/models"
+)
+
+// newHTTPTestClient returns a connected client using the HTTP transport against a test server
+func newHTTPTestClient(t *testing.T, respond func(task map[string]interface{}) interface{}) *Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var tasks []map[string]interface{}
+		if err := json.Unmarshal(body, &tasks); err != nil || len(tasks) == 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(respond(tasks[0]))
+	}))
+	t.Cleanup(server.Close)
+
+	config := DefaultConfig()
+	config.APIKey = testAPIKey
+	config.Transport = TransportHTTP
+	config.HTTPConfig.URL = server.URL
+
+	client, err := NewClient(config)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if err := client.Connect(context.Background()); err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	t.Cleanup(func() { _ = client.Disconnect() })
+	return client
+}
+
+func TestHTTPTransportImageInferenceAll(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		n := int(task["numberResults"].(float64))
+		data := make([]map[string]interface{}, n)
+		for i := range data {
+			data[i] = map[string]interface{}{
+				"taskType":  task["taskType"],
+				"taskUUID":  task["taskUUID"],
+				"imageUUID": string(rune('a' + i)),
+			}
+		}
+		return map[string]interface{}{"data": data}
+	})
+
+	req := NewRequestBuilder(testPrompt, testModel, 512, 512).WithNumberResults(3).Build()
+	images, err := client.ImageInferenceAll(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ImageInferenceAll() error = %v", err)
+	}
+	if len(images) != 3 {
+		t.Fatalf("got %d images, want 3", len(images))
+	}
+}
+
+func TestHTTPTransportUpscaleImage(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		return map[string]interface{}{"data": []map[string]interface{}{{
+			"taskType":  task["taskType"],
+			"taskUUID":  task["taskUUID"],
+			"imageUUID": "upscaled",
+		}}}
+	})
+
+	resp, err := client.UpscaleImage(context.Background(), models.NewUpscaleGanRequest(testUUID, 2))
+	if err != nil {
+		t.Fatalf("UpscaleImage() error = %v", err)
+	}
+	if resp.ImageUUID != "upscaled" {
+		t.Errorf("ImageUUID = %q, want upscaled", resp.ImageUUID)
+	}
+}
+
+func TestHTTPTransportAPIError(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		return map[string]interface{}{"errors": []map[string]interface{}{{
+			"code":     "rateLimitExceeded",
+			"message":  "Too many requests",
+			"taskType": task["taskType"],
+			"taskUUID": task["taskUUID"],
+		}}}
+	})
+
+	_, err := client.TextToImage(context.Background(), testPrompt, testModel, 512, 512)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("TextToImage() error = %v, want *APIError", err)
+	}
+	if !apiErr.IsRetryable() {
+		t.Errorf("expected rateLimitExceeded to be retryable")
+	}
+}
+
+func TestGetResponseReturnsImageResults(t *testing.T) {
+	client := newHTTPTestClient(t, func(task map[string]interface{}) interface{} {
+		return map[string]interface{}{"data": []map[string]interface{}{
+			{"taskType": models.TaskTypeImageInference, "taskUUID": task["taskUUID"], "imageUUID": "img-1"},
+			{"taskType": models.TaskTypeImageInference, "taskUUID": task["taskUUID"], "imageUUID": "img-2"},
+		}}
+	})
+
+	result, err := client.GetResponse(context.Background(), testUUID)
+	if err != nil {
+		t.Fatalf("GetResponse() error = %v", err)
+	}
+	if result.TaskType != models.TaskTypeImageInference {
+		t.Errorf("TaskType = %q, want imageInference", result.TaskType)
+	}
+	if len(result.Images) != 2 || result.Len() != 2 {
+		t.Errorf("got %d images, want 2", len(result.Images))
+	}
+}
@@ -0,0 +1,405 @@
+// Package webhook receives results that the Runware API delivers to a WebhookURL.
+//
+// Register typed callbacks on a Handler and mount it on your HTTP server:
+//
+//	handler := webhook.NewHandler(nil)
+//	handler.OnImageInference(func(ctx context.Context, img *models.ImageInferenceResponse) error {
+//	    fmt.Println("Image ready:", *img.ImageURL)
+//	    return nil
+//	})
+//	handler.OnError(func(ctx context.Context, apiErr *runware.APIError) error {
+//	    log.Printf("task %s failed: %v", apiErr.TaskUUID, apiErr)
+//	    return nil
+//	})
+//	http.Handle("/runware/webhook", handler)
+//
+// Then set WebhookURL on a request to the URL the handler is served at.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	runware "github.com/Ryank90/runware-go-sdk"
+	wsinternal "github.com/Ryank90/runware-go-sdk/internal/ws"
+	models "github.com/Ryank90/runware-go-sdk/models"
+)
+
+const (
+	defaultMaxBodySize = 10 << 20 // 10 MiB, enough for base64 image payloads
+	defaultDedupeTTL   = time.Hour
+)
+
+// Config contains webhook handler configuration options
+type Config struct {
+	// MaxBodySize limits the size of a webhook request body in bytes.
+	// Default: 10 MiB.
+	MaxBodySize int64
+
+	// DedupeTTL is how long a delivery is remembered to detect repeats.
+	// Zero disables deduplication. Default: 1 hour.
+	DedupeTTL time.Duration
+}
+
+// DefaultConfig returns a webhook configuration with sensible defaults
+func DefaultConfig() *Config {
+	return &Config{
+		MaxBodySize: defaultMaxBodySize,
+		DedupeTTL:   defaultDedupeTTL,
+	}
+}
+
+// Handler is an http.Handler that decodes Runware webhook deliveries and
+// dispatches them to the registered callbacks.
+//
+// Repeated deliveries of the same result (same TaskUUID, result UUID and status)
+// within Config.DedupeTTL are acknowledged without invoking the callbacks again.
+//
+// A delivery is only recorded as seen once its callback succeeds. If a callback
+// returns an error, the handler responds with HTTP 500 so the delivery can be
+// retried. A repeat that arrives while the same delivery is still being handled
+// is answered with HTTP 409 so the sender retries it later. Results that no
+// callback consumes are answered with HTTP 501 rather than acknowledged, and
+// malformed payloads with HTTP 400.
+type Handler struct {
+	config *Config
+
+	mu                sync.RWMutex
+	onImage           func(context.Context, *models.ImageInferenceResponse) error
+	onVideo           func(context.Context, *models.VideoInferenceResponse) error
+	onAudio           func(context.Context, *models.AudioInferenceResponse) error
+	onVideoUpscale    func(context.Context, *models.VideoUpscaleResponse) error
+	onVideoBackground func(context.Context, *models.VideoBackgroundRemovalResponse) error
+	onUnhandled       func(ctx context.Context, taskType string, item json.RawMessage) error
+	onError           func(context.Context, *runware.APIError) error
+
+	seenMu    sync.Mutex
+	seen      map[string]time.Time // key -> when the delivery was handled
+	inFlight  map[string]struct{}
+	lastSweep time.Time
+}
+
+var (
+	// errDeliveryInProgress is returned when a repeat arrives while the same
+	// delivery is still being handled
+	errDeliveryInProgress = errors.New("delivery in progress")
+
+	// errUnhandled is returned when no callback is registered for a delivery
+	errUnhandled = errors.New("no callback registered for this delivery")
+
+	// errMalformed is returned for result items that cannot be decoded
+	errMalformed = errors.New("malformed webhook payload")
+)
+
+// NewHandler creates a webhook handler. If config is nil, DefaultConfig() is used.
+func NewHandler(config *Config) *Handler {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	return &Handler{
+		config:    config,
+		seen:      make(map[string]time.Time),
+		inFlight:  make(map[string]struct{}),
+		lastSweep: time.Now(),
+	}
+}
+
+// OnImageInference registers the callback for image inference results
+func (h *Handler) OnImageInference(fn func(context.Context, *models.ImageInferenceResponse) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onImage = fn
+}
+
+// OnVideoInference registers the callback for video inference results
+func (h *Handler) OnVideoInference(fn func(context.Context, *models.VideoInferenceResponse) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onVideo = fn
+}
+
+// OnAudioInference registers the callback for audio inference results
+func (h *Handler) OnAudioInference(fn func(context.Context, *models.AudioInferenceResponse) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onAudio = fn
+}
+
+// OnVideoUpscale registers the callback for video upscale results
+func (h *Handler) OnVideoUpscale(fn func(context.Context, *models.VideoUpscaleResponse) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onVideoUpscale = fn
+}
+
+// OnVideoBackgroundRemoval registers the callback for video background removal results
+func (h *Handler) OnVideoBackgroundRemoval(fn func(context.Context, *models.VideoBackgroundRemovalResponse) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onVideoBackground = fn
+}
+
+// OnUnhandled registers the callback for results that no typed callback consumes,
+// including task types this package does not know. It receives the raw result item.
+func (h *Handler) OnUnhandled(fn func(ctx context.Context, taskType string, item json.RawMessage) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onUnhandled = fn
+}
+
+// OnError registers the callback for error payloads, delivered as *runware.APIError
+func (h *Handler) OnError(fn func(context.Context, *runware.APIError) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onError = fn
+}
+
+// ServeHTTP implements http.Handler
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	maxBodySize := h.config.MaxBodySize
+	if maxBodySize <= 0 {
+		maxBodySize = defaultMaxBodySize
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "failed to read request body", http.StatusBadRequest)
+		return
+	}
+
+	env, err := decodePayload(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	for _, item := range env.Data {
+		if err := h.dispatchResult(r.Context(), item); err != nil {
+			writeDispatchError(w, err)
+			return
+		}
+	}
+	for _, respErr := range env.Errors {
+		if err := h.dispatchError(r.Context(), respErr); err != nil {
+			writeDispatchError(w, err)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// writeDispatchError responds to a failed delivery without exposing callback errors
+func writeDispatchError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, errDeliveryInProgress):
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	case errors.Is(err, errUnhandled):
+		http.Error(w, err.Error(), http.StatusNotImplemented)
+		return
+	case errors.Is(err, errMalformed):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
+
+// decodePayload accepts both the standard API envelope and a bare result object
+func decodePayload(body []byte) (*wsinternal.Envelope, error) {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var items []json.RawMessage
+		if err := json.Unmarshal(trimmed, &items); err != nil {
+			return nil, errMalformed
+		}
+		return &wsinternal.Envelope{Data: items}, nil
+	}
+
+	env, err := wsinternal.DecodeEnvelope(trimmed)
+	if err != nil {
+		return nil, errMalformed
+	}
+	if len(env.Data) == 0 && len(env.Errors) == 0 {
+		// A single result posted without the {"data": [...]} envelope
+		env.Data = []json.RawMessage{trimmed}
+	}
+	return env, nil
+}
+
+// dispatchResult decodes a result item and invokes the matching callback
+func (h *Handler) dispatchResult(ctx context.Context, item json.RawMessage) error {
+	var base struct {
+		TaskType string `json:"taskType"`
+	}
+	taskUUID, result, err := wsinternal.ParseResponseItem(item)
+	if err == nil {
+		err = json.Unmarshal(item, &base)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %w", errMalformed, err)
+	}
+
+	var key string
+	var invoke func() error
+	h.mu.RLock()
+	switch resp := result.(type) {
+	case *models.ImageInferenceResponse:
+		key = dedupeKey(taskUUID, resp.ImageUUID, "")
+		if fn := h.onImage; fn != nil {
+			invoke = func() error { return fn(ctx, resp) }
+		}
+	case *models.VideoInferenceResponse:
+		key = dedupeKey(taskUUID, resp.VideoUUID, resp.Status)
+		if fn := h.onVideo; fn != nil {
+			invoke = func() error { return fn(ctx, resp) }
+		}
+	case *models.AudioInferenceResponse:
+		key = dedupeKey(taskUUID, resp.AudioUUID, resp.Status)
+		if fn := h.onAudio; fn != nil {
+			invoke = func() error { return fn(ctx, resp) }
+		}
+	case *models.VideoUpscaleResponse:
+		key = dedupeKey(taskUUID, resp.VideoUUID, resp.Status)
+		if fn := h.onVideoUpscale; fn != nil {
+			invoke = func() error { return fn(ctx, resp) }
+		}
+	case *models.VideoBackgroundRemovalResponse:
+		key = dedupeKey(taskUUID, resp.VideoUUID, resp.Status)
+		if fn := h.onVideoBackground; fn != nil {
+			invoke = func() error { return fn(ctx, resp) }
+		}
+	}
+	if invoke == nil {
+		// Other task types carry no result UUID this package knows about, so
+		// identify them by their content
+		if key == "" {
+			key = dedupeKey(taskUUID, contentHash(item), "")
+		}
+		if fn := h.onUnhandled; fn != nil {
+			invoke = func() error { return fn(ctx, base.TaskType, item) }
+		}
+	}
+	h.mu.RUnlock()
+
+	if invoke == nil {
+		return fmt.Errorf("%w: %s", errUnhandled, base.TaskType)
+	}
+	return h.deliverOnce(key, invoke)
+}
+
+// contentHash returns a short hash identifying a raw item
+func contentHash(item json.RawMessage) string {
+	sum := fnv.New64a()
+	_, _ = sum.Write(item)
+	return strconv.FormatUint(sum.Sum64(), 16)
+}
+
+// dispatchError converts an error payload to *runware.APIError and invokes the error callback
+func (h *Handler) dispatchError(ctx context.Context, respErr *wsinternal.ResponseError) error {
+	apiErr := runware.NewAPIError(respErr.Response)
+	if len(respErr.Raw) > 0 {
+		apiErr.RawResponse = string(respErr.Raw)
+	}
+
+	var invoke func() error
+	h.mu.RLock()
+	if fn := h.onError; fn != nil {
+		invoke = func() error { return fn(ctx, apiErr) }
+	}
+	h.mu.RUnlock()
+
+	if invoke == nil {
+		return fmt.Errorf("%w: error payload", errUnhandled)
+	}
+	return h.deliverOnce(dedupeKey(apiErr.TaskUUID, "error", ""), invoke)
+}
+
+// deliverOnce invokes the callback unless the delivery was already handled
+func (h *Handler) deliverOnce(key string, invoke func() error) error {
+	if h.config.DedupeTTL <= 0 || key == "" {
+		return invoke()
+	}
+
+	seen, err := h.claim(key)
+	if seen || err != nil {
+		return err
+	}
+	err = invoke()
+	h.release(key, err == nil)
+	return err
+}
+
+// dedupeKey identifies a delivery. Results of multi-result tasks share a TaskUUID,
+// so the result UUID and status are included to tell them apart.
+func dedupeKey(taskUUID, resultUUID string, status models.TaskStatus) string {
+	if taskUUID == "" {
+		return ""
+	}
+	return taskUUID + "|" + resultUUID + "|" + string(status)
+}
+
+// claim reports whether the key was already handled within the TTL. Otherwise it
+// marks the key in flight, failing with errDeliveryInProgress if it already is.
+func (h *Handler) claim(key string) (bool, error) {
+	h.seenMu.Lock()
+	defer h.seenMu.Unlock()
+
+	now := time.Now()
+	if now.Sub(h.lastSweep) > h.config.DedupeTTL {
+		h.sweep(now)
+	}
+
+	if seenAt, ok := h.seen[key]; ok {
+		if now.Sub(seenAt) <= h.config.DedupeTTL {
+			return true, nil
+		}
+		delete(h.seen, key)
+	}
+	if _, ok := h.inFlight[key]; ok {
+		return false, errDeliveryInProgress
+	}
+	h.inFlight[key] = struct{}{}
+	return false, nil
+}
+
+// release clears the in-flight mark, recording the key as seen if the delivery succeeded
+func (h *Handler) release(key string, handled bool) {
+	h.seenMu.Lock()
+	defer h.seenMu.Unlock()
+
+	delete(h.inFlight, key)
+	if handled {
+		h.seen[key] = time.Now()
+	}
+}
+
+// sweep drops expired keys. It runs at most once per TTL so lookups stay cheap,
+// and expired keys that are looked up before then are dropped on access.
+func (h *Handler) sweep(now time.Time) {
+	for k, seenAt := range h.seen {
+		if now.Sub(seenAt) > h.config.DedupeTTL {
+			delete(h.seen, k)
+		}
+	}
+	h.lastSweep = now
+}
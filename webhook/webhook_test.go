@@ -0,0 +1,226 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	runware "github.com/Ryank90/runware-go-sdk"
+	"github.com/Ryank90/runware-go-sdk/models"
+)
+
+func post(t *testing.T, h http.Handler, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandlerDispatchesTypedResults(t *testing.T) {
+	h := NewHandler(nil)
+
+	var images []string
+	var videos []*models.VideoInferenceResponse
+	h.OnImageInference(func(_ context.Context, img *models.ImageInferenceResponse) error {
+		images = append(images, img.ImageUUID)
+		return nil
+	})
+	h.OnVideoInference(func(_ context.Context, v *models.VideoInferenceResponse) error {
+		videos = append(videos, v)
+		return nil
+	})
+
+	rec := post(t, h, `{"data":[
+		{"taskType":"imageInference","taskUUID":"task-1","imageUUID":"img-1"},
+		{"taskType":"imageInference","taskUUID":"task-1","imageUUID":"img-2"}]}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	if len(images) != 2 {
+		t.Errorf("image callback called %d times, want 2", len(images))
+	}
+
+	// A bare result object without the data envelope
+	rec = post(t, h, `{"taskType":"videoInference","taskUUID":"task-2","videoUUID":"vid-1","status":"success"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	if len(videos) != 1 || videos[0].Status != models.TaskStatusSuccess {
+		t.Errorf("videos = %v, want one successful video", videos)
+	}
+}
+
+func TestHandlerVideoProcessingCallbacks(t *testing.T) {
+	h := NewHandler(nil)
+
+	var upscaled, removed []string
+	h.OnVideoUpscale(func(_ context.Context, v *models.VideoUpscaleResponse) error {
+		upscaled = append(upscaled, v.VideoUUID)
+		return nil
+	})
+	h.OnVideoBackgroundRemoval(func(_ context.Context, v *models.VideoBackgroundRemovalResponse) error {
+		removed = append(removed, v.VideoUUID)
+		return nil
+	})
+
+	rec := post(t, h, `{"data":[
+		{"taskType":"videoUpscale","taskUUID":"task-7","videoUUID":"vid-2","status":"success"},
+		{"taskType":"videoBackgroundRemoval","taskUUID":"task-8","videoUUID":"vid-3","status":"success"}]}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	if len(upscaled) != 1 || len(removed) != 1 {
+		t.Errorf("upscaled = %v, removed = %v, want one of each", upscaled, removed)
+	}
+}
+
+func TestHandlerDoesNotAcknowledgeUnhandledResults(t *testing.T) {
+	h := NewHandler(nil)
+
+	payload := `{"data":[{"taskType":"futureTask","taskUUID":"task-9","thingUUID":"x"}]}`
+	if rec := post(t, h, payload); rec.Code != http.StatusNotImplemented {
+		t.Errorf("status = %d, want 501 without a callback for the result", rec.Code)
+	}
+
+	var gotType string
+	h.OnUnhandled(func(_ context.Context, taskType string, item json.RawMessage) error {
+		gotType = taskType
+		return nil
+	})
+	if rec := post(t, h, payload); rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want 200 once OnUnhandled consumes the result", rec.Code)
+	}
+	if gotType != "futureTask" {
+		t.Errorf("OnUnhandled taskType = %q, want futureTask", gotType)
+	}
+}
+
+func TestHandlerDeliversErrorsAsAPIError(t *testing.T) {
+	h := NewHandler(nil)
+
+	var got *runware.APIError
+	h.OnError(func(_ context.Context, apiErr *runware.APIError) error {
+		got = apiErr
+		return nil
+	})
+
+	rec := post(t, h, `{"errors":[{"code":"invalidModel","message":"Unknown model","taskType":"audioInference","taskUUID":"task-3"}]}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	if got == nil || got.ErrorID != "invalidModel" || got.TaskUUID != "task-3" {
+		t.Errorf("APIError = %+v, want invalidModel for task-3", got)
+	}
+	if got != nil && got.RawResponse == "" {
+		t.Error("RawResponse not captured")
+	}
+}
+
+func TestHandlerDeduplicatesDeliveries(t *testing.T) {
+	h := NewHandler(nil)
+
+	calls := 0
+	h.OnAudioInference(func(context.Context, *models.AudioInferenceResponse) error {
+		calls++
+		return nil
+	})
+
+	payload := `{"data":[{"taskType":"audioInference","taskUUID":"task-4","audioUUID":"aud-1","status":"success"}]}`
+	for i := 0; i < 3; i++ {
+		if rec := post(t, h, payload); rec.Code != http.StatusOK {
+			t.Fatalf("status = %d, want 200", rec.Code)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("audio callback called %d times, want 1", calls)
+	}
+}
+
+func TestHandlerCallbackErrorAllowsRetry(t *testing.T) {
+	h := NewHandler(nil)
+
+	calls := 0
+	h.OnImageInference(func(context.Context, *models.ImageInferenceResponse) error {
+		calls++
+		if calls == 1 {
+			return errors.New("database unavailable")
+		}
+		return nil
+	})
+
+	payload := `{"data":[{"taskType":"imageInference","taskUUID":"task-5","imageUUID":"img-1"}]}`
+	rec := post(t, h, payload)
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d, want 500 when the callback fails", rec.Code)
+	}
+	if strings.Contains(rec.Body.String(), "database unavailable") {
+		t.Errorf("response body %q exposes the callback error", rec.Body.String())
+	}
+	if rec := post(t, h, payload); rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want 200 on retry", rec.Code)
+	}
+	if calls != 2 {
+		t.Errorf("callback called %d times, want 2", calls)
+	}
+}
+
+func TestHandlerConcurrentDuplicateIsRetried(t *testing.T) {
+	h := NewHandler(nil)
+
+	started := make(chan struct{})
+	unblock := make(chan struct{})
+	calls := 0
+	h.OnVideoInference(func(context.Context, *models.VideoInferenceResponse) error {
+		calls++
+		close(started)
+		<-unblock
+		return nil
+	})
+
+	payload := `{"data":[{"taskType":"videoInference","taskUUID":"task-6","videoUUID":"vid-1","status":"success"}]}`
+	first := make(chan int)
+	go func() { first <- post(t, h, payload).Code }()
+	<-started
+
+	if rec := post(t, h, payload); rec.Code != http.StatusConflict {
+		t.Errorf("status = %d, want 409 while the delivery is in flight", rec.Code)
+	}
+	close(unblock)
+	if code := <-first; code != http.StatusOK {
+		t.Errorf("first delivery status = %d, want 200", code)
+	}
+	if rec := post(t, h, payload); rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want 200 once the delivery was handled", rec.Code)
+	}
+	if calls != 1 {
+		t.Errorf("video callback called %d times, want 1", calls)
+	}
+}
+
+func TestHandlerRejectsInvalidRequests(t *testing.T) {
+	h := NewHandler(&Config{MaxBodySize: 64, DedupeTTL: 0})
+
+	req := httptest.NewRequest(http.MethodGet, "/webhook", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("GET status = %d, want 405", rec.Code)
+	}
+
+	if rec := post(t, h, `not json`); rec.Code != http.StatusBadRequest {
+		t.Errorf("malformed status = %d, want 400", rec.Code)
+	}
+	if rec := post(t, h, `{"data":["not an object"]}`); rec.Code != http.StatusBadRequest {
+		t.Errorf("malformed item status = %d, want 400", rec.Code)
+	}
+
+	large := `{"data":[{"taskType":"imageInference","taskUUID":"` + strings.Repeat("x", 100) + `"}]}`
+	if rec := post(t, h, large); rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("oversized status = %d, want 413", rec.Code)
+	}
+}